@@ -58,20 +58,9 @@ func CalculateEaster(year int) time.Time {
 // it's the Sunday nearest to November 30 (St. Andrew's Day). This places
 // Advent Sunday between November 27 and December 3 inclusive.
 func CalculateAdvent(year int) time.Time {
-	// Christmas is December 25
-	christmas := time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC)
-
-	// Find the 4th Sunday before Christmas
-	// First, find the Sunday on or before Christmas
-	daysToSubtract := int(christmas.Weekday())
-	if daysToSubtract == 0 {
-		daysToSubtract = 7 // If Christmas is Sunday, go back a full week
-	}
-
-	// That gives us the Sunday before Christmas, now go back 3 more weeks
-	fourthSundayBefore := christmas.AddDate(0, 0, -daysToSubtract-21)
-
-	return fourthSundayBefore
+	// The Sunday nearest November 30 is the Sunday on or before December 3
+	dec3 := time.Date(year, time.December, 3, 0, 0, 0, 0, time.UTC)
+	return dec3.AddDate(0, 0, -int(dec3.Weekday()))
 }
 
 // CalculateAshWednesday calculates Ash Wednesday for a given year.
@@ -35,6 +35,12 @@ func main() {
 		slog.String("log_level", cfg.LogLevel),
 	)
 
+	if cfg.OverrideToday != "" {
+		log.Warn("OVERRIDE_TODAY is set; date-based endpoints will use a fixed date",
+			slog.String("today", cfg.OverrideToday),
+		)
+	}
+
 	// Initialize database
 	log.Info("connecting to database", slog.String("path", cfg.DatabasePath))
 	db, err := database.Open(database.DefaultConfig(cfg.DatabasePath), log)
@@ -0,0 +1,126 @@
+// Command bench measures date lookup throughput against a lectionary database.
+//
+// Usage:
+//
+//	go run ./cmd/bench -db data/lectionary.db -start 2025 -end 2026
+//
+// This tool:
+// 1. Opens the SQLite database (read-only use; no migrations are run)
+// 2. Looks up every calendar date in the year range, repeating -rounds times
+// 3. Prints a JSON report with lookups/sec and p50/p99 latency
+//
+// The JSON output is stable so CI can compare runs. Dates missing from the
+// database still count as lookups; they are reported separately.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/zapponejosh/lectionary-api/internal/database"
+)
+
+// Report is the JSON document printed by the benchmark.
+type Report struct {
+	StartYear     int     `json:"start_year"`
+	EndYear       int     `json:"end_year"`
+	Rounds        int     `json:"rounds"`
+	Lookups       int     `json:"lookups"`
+	Missing       int     `json:"missing"` // Lookups for dates not in the database
+	TotalMs       float64 `json:"total_ms"`
+	LookupsPerSec float64 `json:"lookups_per_sec"`
+	P50Micros     float64 `json:"p50_us"`
+	P99Micros     float64 `json:"p99_us"`
+}
+
+func main() {
+	dbPath := flag.String("db", "data/lectionary.db", "Path to SQLite database")
+	startYear := flag.Int("start", time.Now().Year(), "First calendar year to look up")
+	endYear := flag.Int("end", time.Now().Year(), "Last calendar year to look up (inclusive)")
+	rounds := flag.Int("rounds", 1, "Number of passes over the year range")
+	flag.Parse()
+
+	// Keep stdout clean for the JSON report
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	if err := run(*dbPath, *startYear, *endYear, *rounds, logger, os.Stdout); err != nil {
+		logger.Error("benchmark failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+// run performs the lookups and writes the report to out.
+func run(dbPath string, startYear, endYear, rounds int, logger *slog.Logger, out io.Writer) error {
+	if endYear < startYear {
+		return fmt.Errorf("end year %d is before start year %d", endYear, startYear)
+	}
+	if rounds < 1 {
+		return fmt.Errorf("rounds must be at least 1")
+	}
+
+	db, err := database.Open(database.DefaultConfig(dbPath), logger)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	first := time.Date(startYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(endYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	var latencies []time.Duration
+	missing := 0
+	start := time.Now()
+
+	for round := 0; round < rounds; round++ {
+		for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+			lookupStart := time.Now()
+			_, err := db.GetReadingByDate(ctx, d.Format("2006-01-02"))
+			latencies = append(latencies, time.Since(lookupStart))
+
+			if err != nil {
+				if !database.IsNotFound(err) {
+					return fmt.Errorf("lookup %s: %w", d.Format("2006-01-02"), err)
+				}
+				missing++
+			}
+		}
+	}
+
+	total := time.Since(start)
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	report := Report{
+		StartYear:     startYear,
+		EndYear:       endYear,
+		Rounds:        rounds,
+		Lookups:       len(latencies),
+		Missing:       missing,
+		TotalMs:       float64(total.Microseconds()) / 1000,
+		LookupsPerSec: float64(len(latencies)) / total.Seconds(),
+		P50Micros:     percentile(latencies, 0.50),
+		P99Micros:     percentile(latencies, 0.99),
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// percentile returns the p-th percentile (0-1) of sorted latencies in microseconds.
+func percentile(sorted []time.Duration, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p * float64(len(sorted)-1))
+	return float64(sorted[idx].Nanoseconds()) / 1000
+}
@@ -0,0 +1,77 @@
+// Command export dumps the readings database back to the scraper JSON format.
+//
+// Usage:
+//
+//	go run ./cmd/export -db data/lectionary.db -out backup.json
+//
+// This tool:
+// 1. Opens the SQLite database (read-only use; no migrations are run)
+// 2. Reads every daily reading in date order
+// 3. Writes them in the same JSON format cmd/import consumes
+//
+// Importing the output reproduces the same readings, so it serves both as a
+// backup and as a way to diff the database against a fresh scrape.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/zapponejosh/lectionary-api/internal/database"
+	"github.com/zapponejosh/lectionary-api/internal/importer"
+)
+
+func main() {
+	dbPath := flag.String("db", "data/lectionary.db", "Path to SQLite database")
+	outPath := flag.String("out", "-", "Output JSON file (- for stdout)")
+	flag.Parse()
+
+	// Keep stdout clean for the JSON output
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	if err := run(*dbPath, *outPath, logger); err != nil {
+		logger.Error("export failed", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+}
+
+func run(dbPath, outPath string, logger *slog.Logger) error {
+	ctx := context.Background()
+
+	db, err := database.Open(database.DefaultConfig(dbPath), logger)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+	defer db.Close()
+
+	data, err := importer.Export(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if outPath != "-" {
+		f, err := os.Create(outPath)
+		if err != nil {
+			return fmt.Errorf("create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return fmt.Errorf("write JSON: %w", err)
+	}
+
+	logger.Info("export complete", slog.Int("dates", data.Metadata.TotalDates))
+	return nil
+}
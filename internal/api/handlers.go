@@ -1,15 +1,30 @@
 package api
 
 import (
+	"compress/gzip"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"mime"
 	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/zapponejosh/lectionary-api/internal/calendar"
 	"github.com/zapponejosh/lectionary-api/internal/config"
 	"github.com/zapponejosh/lectionary-api/internal/database"
+	"github.com/zapponejosh/lectionary-api/internal/importer"
+	applog "github.com/zapponejosh/lectionary-api/internal/logger"
+	"github.com/zapponejosh/lectionary-api/internal/scripture"
 )
 
 // Handlers contains all HTTP handlers and their dependencies.
@@ -18,23 +33,49 @@ type Handlers struct {
 	cfg    *config.Config
 	logger *slog.Logger
 	resp   *ResponseWriter
+
+	maintenance *Maintenance
 }
 
 // NewHandlers creates a new Handlers instance.
 func NewHandlers(db *database.DB, cfg *config.Config, logger *slog.Logger) *Handlers {
 	return &Handlers{
-		db:     db,
-		cfg:    cfg,
-		logger: logger,
-		resp:   NewResponseWriter(logger),
+		db:          db,
+		cfg:         cfg,
+		logger:      logger,
+		resp:        NewResponseWriter(logger),
+		maintenance: &Maintenance{},
+	}
+}
+
+// today returns the current date for a request: the OVERRIDE_TODAY date
+// when one is configured (never in production), otherwise today in the
+// request's timezone, falling back to DEFAULT_TIMEZONE.
+func (h *Handlers) today(r *http.Request) time.Time {
+	if fixed, ok := h.cfg.FixedToday(); ok {
+		return fixed
+	}
+	loc, ok := GetRequestTimezone(r)
+	if !ok {
+		loc = h.cfg.DefaultLocation()
+	}
+	return todayIn(loc)
+}
+
+// log returns the handler logger tagged with the request's ID, so a
+// handler's error logs can be matched to the request log line.
+func (h *Handlers) log(r *http.Request) *slog.Logger {
+	if requestID := applog.RequestID(r.Context()); requestID != "" {
+		return h.logger.With(slog.String("request_id", requestID))
 	}
+	return h.logger
 }
 
 // =============================================================================
 // Health Check
 // =============================================================================
 
-// HealthCheck handles GET /health
+// HealthCheck handles GET /health (liveness)
 func (h *Handlers) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -50,43 +91,383 @@ func (h *Handlers) HealthCheck(w http.ResponseWriter, r *http.Request) {
 		stats, _ = h.db.GetReadingStats(ctx)
 	}
 
-	response := map[string]interface{}{
-		"status": "healthy",
-		"database": map[string]interface{}{
-			"healthy": dbHealthy,
+	maintenance, _, _ := h.maintenance.Status()
+
+	// Pool stats come from database/sql and are available even when the
+	// database itself is unreachable.
+	pool := h.db.Stats()
+	dbInfo := map[string]interface{}{
+		"healthy": dbHealthy,
+		"path":    h.db.Path(),
+		"connections": map[string]int{
+			"open":   pool.OpenConnections,
+			"in_use": pool.InUse,
+			"idle":   pool.Idle,
+			"max":    pool.MaxOpenConnections,
 		},
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
 	}
 
 	if stats != nil {
-		response["database"].(map[string]interface{})["total_readings"] = stats.TotalDays
-		response["database"].(map[string]interface{})["date_range"] = map[string]string{
+		dbInfo["total_readings"] = stats.TotalDays
+		dbInfo["date_range"] = map[string]string{
 			"earliest": stats.EarliestDate,
 			"latest":   stats.LatestDate,
 		}
 	}
 
-	if !dbHealthy {
-		h.resp.WriteServiceUnavailable(w, "Database unhealthy")
+	if dbHealthy {
+		if mode, err := h.db.JournalMode(ctx); err == nil {
+			dbInfo["journal_mode"] = mode
+		}
+	}
+
+	response := map[string]interface{}{
+		"status":      "healthy",
+		"database":    dbInfo,
+		"maintenance": maintenance,
+		"timestamp":   time.Now().UTC().Format(time.RFC3339),
+	}
+
+	// Liveness only: the process is serving, so this is always 200.
+	// Orchestrators should gate traffic on /readyz instead.
+	h.resp.WriteSuccess(w, response)
+}
+
+// ReadinessCheck handles GET /readyz
+//
+// Reports whether the instance can serve traffic: the database answers
+// queries and every migration this build knows about has been applied.
+// Returns 503 with the same details when not ready.
+func (h *Handlers) ReadinessCheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dbInfo := map[string]interface{}{
+		"path":      h.db.Path(),
+		"reachable": true,
+	}
+	ready := true
+	reason := ""
+
+	var applied, latest int
+	err := h.db.Health(ctx)
+	if err != nil {
+		dbInfo["reachable"] = false
+	} else {
+		applied, latest, err = h.db.MigrationStatus(ctx)
+	}
+	dbInfo["applied_migrations"] = applied
+	dbInfo["expected_migrations"] = latest
+
+	switch {
+	case err != nil:
+		h.logger.Warn("readiness check: database unavailable", slog.Any("error", err))
+		ready, reason = false, "Database unavailable"
+	case applied != latest:
+		ready, reason = false, "Database migrations pending"
+	}
+
+	response := map[string]interface{}{
+		"ready":     ready,
+		"database":  dbInfo,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if !ready {
+		h.resp.WriteJSON(w, http.StatusServiceUnavailable, Response{
+			Success: false,
+			Data:    response,
+			Error:   &ErrorInfo{Message: reason, Code: "NOT_READY"},
+		})
 		return
 	}
 
 	h.resp.WriteSuccess(w, response)
 }
 
+// =============================================================================
+// Landing Page
+// =============================================================================
+
+// staticFS holds the landing page, favicon, and API docs, embedded at
+// build time.
+//
+//go:embed static
+var staticFS embed.FS
+
+// Index handles GET / with a short HTML page listing the public endpoints.
+func (h *Handlers) Index(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, staticFS, "static/index.html")
+}
+
+// Favicon handles GET /favicon.ico
+func (h *Handlers) Favicon(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "image/x-icon")
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	http.ServeFileFS(w, r, staticFS, "static/favicon.ico")
+}
+
+// OpenAPISpec handles GET /openapi.json and GET /openapi.yaml with the
+// OpenAPI 3 description of the API. The spec is written as JSON, which is
+// also valid YAML, so both routes serve the same document.
+func (h *Handlers) OpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, ".yaml") {
+		w.Header().Set("Content-Type", "application/yaml")
+	}
+	http.ServeFileFS(w, r, staticFS, "static/openapi.json")
+}
+
+// Docs handles GET /docs with a Swagger UI page for the OpenAPI spec.
+func (h *Handlers) Docs(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, staticFS, "static/docs.html")
+}
+
 // =============================================================================
 // Reading Endpoints
 // =============================================================================
 
+// readingOptions holds the presentation options shared by the readings
+// endpoints. The zero value returns readings exactly as stored.
+type readingOptions struct {
+	wholeVerses bool // ?whole_verses=true strips partial-verse suffixes
+	hashes      bool // ?include=hash adds per-reading content hashes
+	psalms      bool // ?expand=psalms adds parsed psalm objects
+
+	types []database.ReadingType // ?type=first,gospel limits the readings returned; nil = all
+}
+
+// includes reports whether readings of type t are returned.
+func (o readingOptions) includes(t database.ReadingType) bool {
+	if o.types == nil {
+		return true
+	}
+	for _, want := range o.types {
+		if want == t {
+			return true
+		}
+	}
+	return false
+}
+
+// shows reports whether a reading of type t appears in the response for
+// a day. Optional readings the day doesn't have are left out unless the
+// client asked for that type.
+func (o readingOptions) shows(t database.ReadingType, reading *database.DailyReading) bool {
+	if !o.includes(t) {
+		return false
+	}
+	return o.types != nil || !t.Optional() || reading.Reference(t) != ""
+}
+
+// readingResponse is a daily reading plus any derived fields requested
+// through readingOptions. Without options it encodes exactly like
+// database.DailyReading.
+type readingResponse struct {
+	*database.DailyReading
+	Hashes map[database.ReadingType]string `json:"hashes,omitempty"`
+
+	// These shadow the embedded references so a ?type= filter can omit
+	// readings and display options don't modify the stored reading
+	FirstReading  *string `json:"first_reading,omitempty"`
+	SecondReading *string `json:"second_reading,omitempty"`
+	GospelReading *string `json:"gospel_reading,omitempty"`
+	Canticle      *string `json:"canticle,omitempty"`
+
+	MorningPsalmsExpanded []scripture.Psalm `json:"morning_psalms_expanded,omitempty"`
+	EveningPsalmsExpanded []scripture.Psalm `json:"evening_psalms_expanded,omitempty"`
+}
+
+// parseReadingOptions reads the presentation query parameters.
+func parseReadingOptions(r *http.Request) (readingOptions, error) {
+	var opts readingOptions
+
+	if v := r.URL.Query().Get("whole_verses"); v != "" {
+		wholeVerses, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("whole_verses must be true or false")
+		}
+		opts.wholeVerses = wholeVerses
+	}
+
+	if v := r.URL.Query().Get("include"); v != "" {
+		for _, field := range strings.Split(v, ",") {
+			switch strings.TrimSpace(field) {
+			case "hash":
+				opts.hashes = true
+			default:
+				return opts, fmt.Errorf("unknown include value %q", field)
+			}
+		}
+	}
+
+	if v := r.URL.Query().Get("type"); v != "" {
+		opts.types = []database.ReadingType{}
+		for _, field := range strings.Split(v, ",") {
+			t := database.ReadingType(strings.ToLower(strings.TrimSpace(field)))
+			if !slices.Contains(database.ValidReadingTypes, t) {
+				return opts, fmt.Errorf("unknown type %q; use first, second, gospel, or canticle", field)
+			}
+			if !slices.Contains(opts.types, t) {
+				opts.types = append(opts.types, t)
+			}
+		}
+	}
+
+	if v := r.URL.Query().Get("expand"); v != "" {
+		for _, field := range strings.Split(v, ",") {
+			switch strings.TrimSpace(field) {
+			case "psalms":
+				opts.psalms = true
+			default:
+				return opts, fmt.Errorf("unknown expand value %q", field)
+			}
+		}
+	}
+
+	return opts, nil
+}
+
+// expandPsalms parses stored psalm citations. Citations that don't parse
+// are kept with only their raw text.
+func expandPsalms(raw []string) []scripture.Psalm {
+	psalms := make([]scripture.Psalm, 0, len(raw))
+	for _, citation := range raw {
+		p, _ := scripture.ParsePsalm(citation)
+		psalms = append(psalms, p)
+	}
+	return psalms
+}
+
+// render applies the options to a reading. Hashes are taken from the
+// stored references, so they don't change with display options.
+func (o readingOptions) render(reading *database.DailyReading) readingResponse {
+	resp := readingResponse{DailyReading: reading}
+
+	if o.hashes {
+		resp.Hashes = make(map[database.ReadingType]string, len(database.ValidReadingTypes))
+		for _, t := range database.ValidReadingTypes {
+			if o.shows(t, reading) {
+				resp.Hashes[t] = database.ReadingHash(t, reading.Reference(t))
+			}
+		}
+	}
+
+	if o.psalms {
+		resp.MorningPsalmsExpanded = expandPsalms(reading.MorningPsalms)
+		resp.EveningPsalmsExpanded = expandPsalms(reading.EveningPsalms)
+	}
+
+	for _, t := range database.ValidReadingTypes {
+		if !o.shows(t, reading) {
+			continue
+		}
+		ref := reading.Reference(t)
+		if o.wholeVerses {
+			ref = scripture.WholeVerses(ref)
+		}
+		switch t {
+		case database.ReadingTypeFirst:
+			resp.FirstReading = &ref
+		case database.ReadingTypeSecond:
+			resp.SecondReading = &ref
+		case database.ReadingTypeGospel:
+			resp.GospelReading = &ref
+		case database.ReadingTypeCanticle:
+			resp.Canticle = &ref
+		}
+	}
+
+	return resp
+}
+
+// parseYear validates a year path or query value. Years before the
+// Gregorian reform aren't supported by the feast calculations.
+func parseYear(value string) (int, error) {
+	year, err := strconv.Atoi(value)
+	if err != nil || year < calendar.MinYear || year > calendar.MaxYear {
+		return 0, fmt.Errorf("Invalid year. Use a year from %d to %d", calendar.MinYear, calendar.MaxYear)
+	}
+	return year, nil
+}
+
+// errDateOutOfRange reports a well-formed date in a year the calendar
+// calculations don't support.
+var errDateOutOfRange = fmt.Errorf("Date out of range. Use a year from %d to %d", calendar.MinYear, calendar.MaxYear)
+
+// parseDate parses a YYYY-MM-DD request date. Dates before the Gregorian
+// reform or past calendar.MaxYear return errDateOutOfRange rather than
+// resolving to nonsense liturgical days.
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if date.Year() < calendar.MinYear || date.Year() > calendar.MaxYear {
+		return time.Time{}, errDateOutOfRange
+	}
+	return date, nil
+}
+
+// dateError returns the client message for a parseDate error: the range
+// message for out-of-range dates, otherwise formatMsg.
+func dateError(err error, formatMsg string) string {
+	if errors.Is(err, errDateOutOfRange) {
+		return err.Error()
+	}
+	return formatMsg
+}
+
+// writeReading writes a single day's readings as JSON, or as plain text
+// when the client prefers text/plain. Responses carry an ETag and
+// Last-Modified, and conditional requests for an unchanged reading get 304.
+func (h *Handlers) writeReading(w http.ResponseWriter, r *http.Request, opts readingOptions, reading *database.DailyReading) {
+	w.Header().Set("Vary", "Accept")
+	if h.resp.NotModified(w, r, readingETag(r, reading), reading.UpdatedAt) {
+		return
+	}
+
+	if prefersPlainText(r) {
+		h.resp.WriteText(w, http.StatusOK, formatReadingText(opts.render(reading)))
+		return
+	}
+	h.resp.WriteSuccess(w, opts.render(reading))
+}
+
+// readingETag derives a strong ETag from the stored reading and everything
+// that shapes its representation (query options and text vs JSON), so it
+// changes whenever the response body would.
+func readingETag(r *http.Request, reading *database.DailyReading) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%t\x00%s",
+		reading.Date,
+		strings.Join(reading.MorningPsalms, ";"),
+		strings.Join(reading.EveningPsalms, ";"),
+		reading.FirstReading,
+		reading.SecondReading,
+		reading.GospelReading,
+		reading.Canticle,
+		reading.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		prefersPlainText(r),
+		r.URL.RawQuery,
+	)
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
 // GetTodayReadings handles GET /api/v1/readings/today
 //
 // Supports timezone via X-Timezone header.
-// If no timezone is provided, defaults to UTC.
+// If no timezone is provided, defaults to DEFAULT_TIMEZONE (UTC unless set).
+// Send Accept: text/plain for a human-readable block instead of JSON.
 func (h *Handlers) GetTodayReadings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
 	// Get "today" in the context of the user's timezone
-	today := GetTodayForRequest(r)
+	today := h.today(r)
 	dateStr := today.Format("2006-01-02")
 
 	h.logger.Debug("fetching today's readings",
@@ -101,7 +482,7 @@ func (h *Handlers) GetTodayReadings(w http.ResponseWriter, r *http.Request) {
 			h.resp.WriteNotFound(w, fmt.Sprintf("No readings found for %s", dateStr))
 			return
 		}
-		h.logger.Error("failed to get today's readings",
+		h.log(r).Error("failed to get today's readings",
 			slog.String("date", dateStr),
 			slog.String("error", err.Error()),
 		)
@@ -109,10 +490,11 @@ func (h *Handlers) GetTodayReadings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.resp.WriteSuccess(w, readings)
+	h.writeReading(w, r, opts, readings)
 }
 
 // GetDateReadings handles GET /api/v1/readings/date/{date}
+// Send Accept: text/plain for a human-readable block instead of JSON.
 func (h *Handlers) GetDateReadings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -123,10 +505,16 @@ func (h *Handlers) GetDateReadings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate date format
-	_, err := time.Parse("2006-01-02", dateStr)
+	// Validate date format and year
+	_, err := parseDate(dateStr)
 	if err != nil {
-		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid date format. Use YYYY-MM-DD"))
+		return
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
 		return
 	}
 
@@ -141,7 +529,7 @@ func (h *Handlers) GetDateReadings(w http.ResponseWriter, r *http.Request) {
 			h.resp.WriteNotFound(w, fmt.Sprintf("No readings found for %s", dateStr))
 			return
 		}
-		h.logger.Error("failed to get readings",
+		h.log(r).Error("failed to get readings",
 			slog.String("date", dateStr),
 			slog.String("error", err.Error()),
 		)
@@ -149,7 +537,92 @@ func (h *Handlers) GetDateReadings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.resp.WriteSuccess(w, readings)
+	h.writeReading(w, r, opts, readings)
+}
+
+// GetNextSundayReadings handles GET /api/v1/readings/next-sunday?from=YYYY-MM-DD
+//
+// Returns the readings for the next Sunday on or after today (in the
+// request's timezone, as for /readings/today), or on or after from if
+// given. A Sunday returns its own readings.
+func (h *Handlers) GetNextSundayReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	from := h.today(r)
+	if fromStr := r.URL.Query().Get("from"); fromStr != "" {
+		parsed, err := parseDate(fromStr)
+		if err != nil {
+			h.resp.WriteBadRequest(w, dateError(err, "Invalid from date format. Use YYYY-MM-DD"))
+			return
+		}
+		from = parsed
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	sunday := from.AddDate(0, 0, (7-int(from.Weekday()))%7)
+	dateStr := sunday.Format("2006-01-02")
+
+	h.logger.Debug("fetching next Sunday's readings",
+		slog.String("from", from.Format("2006-01-02")),
+		slog.String("date", dateStr),
+	)
+
+	readings, err := h.db.GetReadingByDate(ctx, dateStr)
+	if err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No readings found for %s", dateStr))
+			return
+		}
+		h.log(r).Error("failed to get next Sunday's readings",
+			slog.String("date", dateStr),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	h.writeReading(w, r, opts, readings)
+}
+
+// GetReadingByID handles GET /api/v1/readings/{id}
+//
+// Returns a single day's readings by the id included in every reading
+// response, so clients can re-fetch a reading they stored.
+func (h *Handlers) GetReadingByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id < 1 {
+		h.resp.WriteBadRequest(w, "Invalid reading ID")
+		return
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	reading, err := h.db.GetReadingByID(ctx, id)
+	if err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No reading found with ID %d", id))
+			return
+		}
+		h.log(r).Error("failed to get reading by id",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	h.resp.WriteSuccess(w, opts.render(reading))
 }
 
 // GetRangeReadings handles GET /api/v1/readings/range
@@ -167,15 +640,15 @@ func (h *Handlers) GetRangeReadings(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Validate date formats
-	_, err := time.Parse("2006-01-02", startDate)
+	start, err := parseDate(startDate)
 	if err != nil {
-		h.resp.WriteBadRequest(w, "Invalid start date format. Use YYYY-MM-DD")
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid start date format. Use YYYY-MM-DD"))
 		return
 	}
 
-	_, err = time.Parse("2006-01-02", endDate)
+	end, err := parseDate(endDate)
 	if err != nil {
-		h.resp.WriteBadRequest(w, "Invalid end date format. Use YYYY-MM-DD")
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid end date format. Use YYYY-MM-DD"))
 		return
 	}
 
@@ -185,15 +658,26 @@ func (h *Handlers) GetRangeReadings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
 	h.logger.Debug("fetching readings for range",
 		slog.String("start", startDate),
 		slog.String("end", endDate),
 	)
 
+	if acceptsNDJSON(r) {
+		h.streamRangeNDJSON(w, r, startDate, endDate, opts)
+		return
+	}
+
 	// Fetch from database
 	readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
 	if err != nil {
-		h.logger.Error("failed to get readings range",
+		h.log(r).Error("failed to get readings range",
 			slog.String("start", startDate),
 			slog.String("end", endDate),
 			slog.String("error", err.Error()),
@@ -202,84 +686,1536 @@ func (h *Handlers) GetRangeReadings(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Return empty array if no readings found (not an error)
-	if len(readings) == 0 {
-		h.resp.WriteSuccess(w, []interface{}{})
+	rendered := make([]readingResponse, len(readings))
+	for i := range readings {
+		rendered[i] = opts.render(&readings[i])
+	}
+
+	if wantsCSV(r) {
+		h.resp.WriteCSV(w, fmt.Sprintf("readings_%s_%s.csv", startDate, endDate), readingsCSV(rendered))
 		return
 	}
 
-	h.resp.WriteSuccess(w, readings)
+	h.resp.WriteSuccessWithMeta(w, rendered, rangeMeta(start, end, readings))
 }
 
-// Replace the progress endpoint placeholders in handlers.go with these implementations
-
-// =============================================================================
-// Progress Endpoints (Fully Implemented)
-// =============================================================================
+// maxBatchDates caps POST /api/v1/readings/dates.
+const maxBatchDates = 31
 
-// GetProgress handles GET /api/v1/progress
-// Returns paginated list of completed readings for the authenticated user.
-// Query params: limit (default 50, max 100), offset (default 0)
-func (h *Handlers) GetProgress(w http.ResponseWriter, r *http.Request) {
+// GetReadingsForDates handles POST /api/v1/readings/dates
+//
+// Takes a JSON array of up to 31 dates ("YYYY-MM-DD") and returns their
+// readings keyed by date, so apps can prefetch scattered days in one call.
+// If any date is malformed the whole request is rejected with 400; dates
+// that are well formed but have no readings are left out of data and
+// listed in meta.errors, as for the range endpoint. Duplicates count once.
+func (h *Handlers) GetReadingsForDates(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
-	userID := GetUserID(r)
-
-	// Parse pagination parameters
-	limit := 50 // default
-	offset := 0 // default
 
-	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
-		if parsed, err := strconv.Atoi(limitStr); err == nil {
-			if parsed > 0 && parsed <= 100 {
-				limit = parsed
-			}
-		}
+	var dates []string
+	if err := json.NewDecoder(r.Body).Decode(&dates); err != nil {
+		h.resp.WriteBadRequest(w, "Request body must be a JSON array of dates")
+		return
+	}
+	if len(dates) == 0 {
+		h.resp.WriteBadRequest(w, "At least one date is required")
+		return
+	}
+	if len(dates) > maxBatchDates {
+		h.resp.WriteBadRequest(w, fmt.Sprintf("At most %d dates per request", maxBatchDates))
+		return
 	}
 
-	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
-		if parsed, err := strconv.Atoi(offsetStr); err == nil {
-			if parsed >= 0 {
-				offset = parsed
-			}
+	for _, date := range dates {
+		if _, err := parseDate(date); err != nil {
+			h.resp.WriteBadRequest(w, dateError(err, fmt.Sprintf("Invalid date %q. Use YYYY-MM-DD", date)))
+			return
 		}
 	}
+	slices.Sort(dates)
+	dates = slices.Compact(dates)
 
-	h.logger.Debug("fetching user progress",
-		slog.String("user_id", userID),
-		slog.Int("limit", limit),
-		slog.Int("offset", offset),
-	)
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
 
-	// Fetch progress from database
-	progress, err := h.db.GetProgressByUser(ctx, userID, limit, offset)
+	readings, err := h.db.GetReadingsByDates(ctx, dates)
 	if err != nil {
-		h.logger.Error("failed to get progress",
-			slog.String("user_id", userID),
+		h.log(r).Error("failed to get readings for dates",
+			slog.Int("dates", len(dates)),
 			slog.String("error", err.Error()),
 		)
-		h.resp.WriteInternalError(w, "Failed to retrieve progress")
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
 		return
 	}
 
-	h.resp.WriteSuccess(w, map[string]interface{}{
-		"progress": progress,
-		"limit":    limit,
-		"offset":   offset,
-		"count":    len(progress),
-	})
+	byDate := make(map[string]readingResponse, len(readings))
+	for i := range readings {
+		byDate[readings[i].Date] = opts.render(&readings[i])
+	}
+
+	meta := rangeMetadata{RequestedDays: len(dates), Returned: len(readings), Errors: []rangeError{}}
+	for _, date := range dates {
+		if _, ok := byDate[date]; !ok {
+			meta.Errors = append(meta.Errors, rangeError{Date: date, Message: "No readings found for this date"})
+		}
+	}
+	meta.Missing = len(meta.Errors)
+
+	h.resp.WriteSuccessWithMeta(w, byDate, meta)
+}
+
+// rangeError explains why a day in a range has no readings.
+type rangeError struct {
+	Date    string `json:"date"`
+	Message string `json:"message"`
+}
+
+// rangeMetadata reports how completely a range request was answered.
+// The response is still 200 when days are missing.
+type rangeMetadata struct {
+	RequestedDays int          `json:"requested_days"`
+	Returned      int          `json:"returned"`
+	Missing       int          `json:"missing"`
+	Errors        []rangeError `json:"errors"`
+}
+
+// rangeMeta lists the days from start through end that have no stored
+// readings, given the readings found for the range in date order.
+func rangeMeta(start, end time.Time, readings []database.DailyReading) rangeMetadata {
+	meta := rangeMetadata{Returned: len(readings), Errors: []rangeError{}}
+
+	i := 0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		meta.RequestedDays++
+		date := day.Format("2006-01-02")
+		if i < len(readings) && readings[i].Date == date {
+			i++
+			continue
+		}
+		meta.Errors = append(meta.Errors, rangeError{Date: date, Message: "No readings found for this date"})
+	}
+
+	meta.Missing = len(meta.Errors)
+	return meta
+}
+
+// maxICSDays caps the calendar export, which is meant for a year of
+// subscriptions rather than the whole database.
+const maxICSDays = 366
+
+// GetCalendarICS handles GET /api/v1/calendar.ics?start=YYYY-MM-DD&end=YYYY-MM-DD
+//
+// Returns an iCalendar feed with one all-day event per stored date,
+// summarized by the feast or season and listing the psalms and readings,
+// for subscribing from Google or Apple Calendar. Up to a year per request.
+func (h *Handlers) GetCalendarICS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	startDate := r.URL.Query().Get("start")
+	endDate := r.URL.Query().Get("end")
+	if startDate == "" || endDate == "" {
+		h.resp.WriteBadRequest(w, "Both start and end date parameters are required")
+		return
+	}
+
+	start, err := parseDate(startDate)
+	if err != nil {
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid start date format. Use YYYY-MM-DD"))
+		return
+	}
+	end, err := parseDate(endDate)
+	if err != nil {
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid end date format. Use YYYY-MM-DD"))
+		return
+	}
+	if end.Before(start) {
+		h.resp.WriteBadRequest(w, "Start date must be before or equal to end date")
+		return
+	}
+	if days := calendar.DaysBetween(start, end) + 1; days > maxICSDays {
+		h.resp.WriteBadRequest(w, fmt.Sprintf("Date range cannot exceed %d days", maxICSDays))
+		return
+	}
+
+	readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		h.log(r).Error("failed to get readings for calendar",
+			slog.String("start", startDate),
+			slog.String("end", endDate),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	events := make([]icsEvent, 0, len(readings))
+	for _, reading := range readings {
+		date, err := time.Parse("2006-01-02", reading.Date)
+		if err != nil {
+			continue
+		}
+
+		events = append(events, icsEvent{
+			UID:         reading.Date + "@lectionary-api",
+			Date:        date,
+			Stamp:       reading.UpdatedAt,
+			Summary:     h.dayName(date),
+			Description: readingSummary(&reading),
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="lectionary.ics"`)
+
+	if err := writeICS(w, "Daily Lectionary", events); err != nil {
+		// Headers are already sent, so we can only log
+		h.log(r).Error("failed to write calendar",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// dayName names a day by its feast, then (if EMBER_ROGATION_DAYS is set)
+// its Ember or Rogation Day, and otherwise its season.
+func (h *Handlers) dayName(date time.Time) string {
+	if feast, ok := calendar.FeastOn(date); ok {
+		return feast.Name
+	}
+	if h.cfg.EmberRogation {
+		if name, ok := calendar.ObservanceOn(date); ok {
+			return name
+		}
+	}
+	return calendar.SeasonOf(date).Name
+}
+
+// readingSummary lists a day's psalms and readings, one per line, for
+// the calendar and feed exports.
+func readingSummary(reading *database.DailyReading) string {
+	lines := []string{
+		"Morning Psalms: " + strings.Join(reading.MorningPsalms, "; "),
+		"First Reading: " + reading.FirstReading,
+		"Second Reading: " + reading.SecondReading,
+		"Gospel: " + reading.GospelReading,
+	}
+	if reading.Canticle != "" {
+		lines = append(lines, "Canticle: "+reading.Canticle)
+	}
+	lines = append(lines, "Evening Psalms: "+strings.Join(reading.EveningPsalms, "; "))
+	return strings.Join(lines, "\n")
+}
+
+const (
+	// defaultFeedDays and maxFeedDays bound the days in the Atom feed.
+	defaultFeedDays = 7
+	maxFeedDays     = 31
+)
+
+// GetFeedAtom handles GET /api/v1/feed.atom?days=N
+//
+// Returns an Atom feed of the last N days of readings, ending today and
+// newest first (default 7, up to 31), for feed readers and parish
+// websites. Entry IDs depend only on the date, so readers don't show a
+// day twice.
+func (h *Handlers) GetFeedAtom(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	days := defaultFeedDays
+	if daysStr := r.URL.Query().Get("days"); daysStr != "" {
+		parsed, err := strconv.Atoi(daysStr)
+		if err != nil || parsed < 1 || parsed > maxFeedDays {
+			h.resp.WriteBadRequest(w, fmt.Sprintf("days must be a number from 1 to %d", maxFeedDays))
+			return
+		}
+		days = parsed
+	}
+
+	end := h.today(r)
+	start := end.AddDate(0, 0, -(days - 1))
+	startDate, endDate := start.Format("2006-01-02"), end.Format("2006-01-02")
+
+	readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		h.log(r).Error("failed to get readings for feed",
+			slog.String("start", startDate),
+			slog.String("end", endDate),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	feed := atomFeed{
+		ID:     atomTag("readings"),
+		Title:  "Daily Lectionary",
+		Author: atomAuthor{Name: "Lectionary API"},
+		Links:  []atomLink{{Rel: "self", Href: r.URL.RequestURI()}},
+	}
+
+	var updated time.Time
+	for i := len(readings) - 1; i >= 0; i-- {
+		reading := &readings[i]
+		date, err := time.Parse("2006-01-02", reading.Date)
+		if err != nil {
+			continue
+		}
+		if reading.UpdatedAt.After(updated) {
+			updated = reading.UpdatedAt
+		}
+
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:      atomTag("readings/" + reading.Date),
+			Title:   date.Format("Monday, January 2, 2006") + " - " + h.dayName(date),
+			Updated: atomTime(reading.UpdatedAt),
+			Link:    atomLink{Href: "/api/v1/readings/date/" + reading.Date},
+			Summary: readingSummary(reading),
+		})
+	}
+	if updated.IsZero() {
+		updated = end
+	}
+	feed.Updated = atomTime(updated)
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+
+	if err := writeAtom(w, feed); err != nil {
+		// Headers are already sent, so we can only log
+		h.log(r).Error("failed to write feed",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// acceptsNDJSON reports whether the Accept header asks for newline-delimited JSON.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == "application/x-ndjson" {
+			return true
+		}
+	}
+	return false
+}
+
+// wantsCSV reports whether the client asked for CSV with ?format=csv or
+// an Accept header listing text/csv.
+func wantsCSV(r *http.Request) bool {
+	if strings.EqualFold(r.URL.Query().Get("format"), "csv") {
+		return true
+	}
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == "text/csv" {
+			return true
+		}
+	}
+	return false
+}
+
+// readingsCSV flattens readings into CSV rows, one per psalm or scripture
+// passage, under a header row. References citing several passages become
+// separate rows, each with its book (see scripture.Passages).
+func readingsCSV(readings []readingResponse) [][]string {
+	rows := [][]string{{"date", "season", "reading_type", "reference"}}
+
+	for _, reading := range readings {
+		season := ""
+		if date, err := time.Parse("2006-01-02", reading.Date); err == nil {
+			season = calendar.SeasonOf(date).Name
+		}
+		add := func(readingType, reference string) {
+			if reference = strings.TrimSpace(reference); reference != "" {
+				rows = append(rows, []string{reading.Date, season, readingType, reference})
+			}
+		}
+
+		for _, psalm := range reading.MorningPsalms {
+			add("morning_psalm", psalm)
+		}
+		for _, ref := range []struct {
+			readingType database.ReadingType
+			reference   *string
+		}{
+			{database.ReadingTypeFirst, reading.FirstReading},
+			{database.ReadingTypeSecond, reading.SecondReading},
+			{database.ReadingTypeGospel, reading.GospelReading},
+			{database.ReadingTypeCanticle, reading.Canticle},
+		} {
+			if ref.reference == nil {
+				continue
+			}
+			for _, passage := range scripture.Passages(*ref.reference) {
+				add(string(ref.readingType), passage)
+			}
+		}
+		for _, psalm := range reading.EveningPsalms {
+			add("evening_psalm", psalm)
+		}
+	}
+
+	return rows
+}
+
+// prefersPlainText reports whether the Accept header asks for text/plain
+// ahead of JSON. JSON stays the default when Accept is absent.
+func prefersPlainText(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case "text/plain":
+			return true
+		case "application/json":
+			return false
+		}
+	}
+	return false
+}
+
+// streamRangeNDJSON writes a date range as one bare JSON reading per line,
+// flushing after each so memory stays flat and consumers can start early.
+// There is no response envelope; an empty range is an empty body.
+func (h *Handlers) streamRangeNDJSON(w http.ResponseWriter, r *http.Request, startDate, endDate string, opts readingOptions) {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+
+	rc := http.NewResponseController(w)
+	enc := json.NewEncoder(w)
+	count := 0
+
+	err := h.db.StreamReadingsByDateRange(r.Context(), startDate, endDate, func(reading *database.DailyReading) error {
+		if err := enc.Encode(opts.render(reading)); err != nil {
+			return err
+		}
+		count++
+		return rc.Flush()
+	})
+	if err != nil {
+		// Headers are already sent once a row is written, so we can only log
+		h.log(r).Error("failed to stream readings range",
+			slog.String("start", startDate),
+			slog.String("end", endDate),
+			slog.Int("written", count),
+			slog.String("error", err.Error()),
+		)
+		if count == 0 {
+			h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		}
+	}
+}
+
+// GetMonthReadings handles GET /api/v1/readings/month/{month}
+//
+// Returns every stored reading in a calendar month (YYYY-MM), in date
+// order, shaped like the range response: the readings array in data and
+// the range meta, plus the month, in meta.
+func (h *Handlers) GetMonthReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	monthStr := r.PathValue("month")
+	month, err := time.Parse("2006-01", monthStr)
+	if err != nil {
+		h.resp.WriteBadRequest(w, "Invalid month format. Use YYYY-MM with a month from 01 to 12")
+		return
+	}
+	if month.Year() < calendar.MinYear || month.Year() > calendar.MaxYear {
+		h.resp.WriteBadRequest(w, errDateOutOfRange.Error())
+		return
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	end := month.AddDate(0, 1, -1)
+	startDate := month.Format("2006-01-02")
+	endDate := end.Format("2006-01-02")
+
+	h.logger.Debug("fetching readings for month",
+		slog.String("month", monthStr),
+	)
+
+	readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		h.log(r).Error("failed to get month readings",
+			slog.String("month", monthStr),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	rendered := make([]readingResponse, len(readings))
+	for i := range readings {
+		rendered[i] = opts.render(&readings[i])
+	}
+
+	if wantsCSV(r) {
+		h.resp.WriteCSV(w, fmt.Sprintf("readings_%s.csv", month.Format("2006-01")), readingsCSV(rendered))
+		return
+	}
+
+	h.resp.WriteSuccessWithMeta(w, rendered, monthMetadata{
+		Month:         month.Format("2006-01"),
+		rangeMetadata: rangeMeta(month, end, readings),
+	})
+}
+
+// monthMetadata is rangeMetadata for a month request, naming the month.
+type monthMetadata struct {
+	Month string `json:"month"`
+	rangeMetadata
+}
+
+// GetWeekReadings handles GET /api/v1/readings/week/{date}
+//
+// Returns the seven days (Sunday through Saturday) of the week containing
+// the date, in order. Days without stored readings are listed with null
+// readings so clients always get a full week.
+func (h *Handlers) GetWeekReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dateStr := r.PathValue("date")
+	date, err := parseDate(dateStr)
+	if err != nil {
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid date format. Use YYYY-MM-DD"))
+		return
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	sunday := date.AddDate(0, 0, -int(date.Weekday()))
+	startDate := sunday.Format("2006-01-02")
+	endDate := sunday.AddDate(0, 0, 6).Format("2006-01-02")
+
+	h.logger.Debug("fetching readings for week",
+		slog.String("start", startDate),
+		slog.String("end", endDate),
+	)
+
+	readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		h.log(r).Error("failed to get week readings",
+			slog.String("date", dateStr),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	byDate := make(map[string]*database.DailyReading, len(readings))
+	for i := range readings {
+		byDate[readings[i].Date] = &readings[i]
+	}
+
+	type dayEntry struct {
+		Date     string           `json:"date"`
+		Weekday  string           `json:"weekday"`
+		Readings *readingResponse `json:"readings"`
+	}
+
+	days := make([]dayEntry, 0, 7)
+	for i := 0; i < 7; i++ {
+		day := sunday.AddDate(0, 0, i)
+		entry := dayEntry{Date: day.Format("2006-01-02"), Weekday: day.Weekday().String()}
+		if reading, ok := byDate[entry.Date]; ok {
+			rendered := opts.render(reading)
+			entry.Readings = &rendered
+		}
+		days = append(days, entry)
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"date":  date.Format("2006-01-02"),
+		"start": startDate,
+		"end":   endDate,
+		"days":  days,
+	})
+}
+
+// GetSeasonReadings handles GET /api/v1/readings/season/{name}?year=YYYY
+//
+// Returns every stored reading in a liturgical season (advent, christmas,
+// epiphany, lent, easter, ordinary-time). year is the calendar year in
+// which the liturgical year begins at Advent; it defaults to the
+// liturgical year containing today. Ordinary Time spans both its runs.
+func (h *Handlers) GetSeasonReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	season, ok := calendar.LookupSeason(r.PathValue("name"))
+	if !ok {
+		h.resp.WriteNotFound(w, "Unknown season")
+		return
+	}
+
+	year := calendar.LiturgicalYearOf(h.today(r))
+	if yearStr := r.URL.Query().Get("year"); yearStr != "" {
+		parsed, err := parseYear(yearStr)
+		if err != nil {
+			h.resp.WriteBadRequest(w, err.Error())
+			return
+		}
+		year = parsed
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	h.logger.Debug("fetching readings for season",
+		slog.String("season", season.Key),
+		slog.Int("year", year),
+	)
+
+	spans := season.Spans(year)
+	spansJSON := make([]map[string]string, 0, len(spans))
+	rendered := []readingResponse{}
+
+	for _, span := range spans {
+		startDate, endDate := span.Start.Format("2006-01-02"), span.End.Format("2006-01-02")
+		spansJSON = append(spansJSON, map[string]string{"start": startDate, "end": endDate})
+
+		readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
+		if err != nil {
+			h.log(r).Error("failed to get season readings",
+				slog.String("season", season.Key),
+				slog.Int("year", year),
+				slog.String("error", err.Error()),
+			)
+			h.resp.WriteInternalError(w, "Failed to retrieve readings")
+			return
+		}
+		for i := range readings {
+			rendered = append(rendered, opts.render(&readings[i]))
+		}
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"season":   season.Key,
+		"name":     season.Name,
+		"year":     year,
+		"spans":    spansJSON,
+		"count":    len(rendered),
+		"readings": rendered,
+	})
+}
+
+// GetTodayPsalms handles GET /api/v1/psalms/today?office=morning|evening
+//
+// Returns only the appointed psalms for today (X-Timezone aware). Without
+// ?office both the morning and evening psalms are returned.
+func (h *Handlers) GetTodayPsalms(w http.ResponseWriter, r *http.Request) {
+	h.writePsalms(w, r, h.today(r).Format("2006-01-02"))
+}
+
+// GetDatePsalms handles GET /api/v1/readings/date/{date}/psalms?office=morning|evening
+//
+// Returns only the appointed psalms for a date, for daily office apps that
+// don't need the readings.
+func (h *Handlers) GetDatePsalms(w http.ResponseWriter, r *http.Request) {
+	dateStr := r.PathValue("date")
+	if _, err := parseDate(dateStr); err != nil {
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid date format. Use YYYY-MM-DD"))
+		return
+	}
+
+	h.writePsalms(w, r, dateStr)
+}
+
+// writePsalms writes the psalms for a date, honoring ?office. Only the
+// psalm columns are queried.
+func (h *Handlers) writePsalms(w http.ResponseWriter, r *http.Request, dateStr string) {
+	ctx := r.Context()
+
+	office := r.URL.Query().Get("office")
+	if office != "" && office != "morning" && office != "evening" {
+		h.resp.WriteBadRequest(w, "office must be morning or evening")
+		return
+	}
+
+	morning, evening, err := h.db.GetPsalmsByDate(ctx, dateStr)
+	if err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No readings found for %s", dateStr))
+			return
+		}
+		h.log(r).Error("failed to get psalms",
+			slog.String("date", dateStr),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve psalms")
+		return
+	}
+
+	response := map[string]interface{}{
+		"date": dateStr,
+	}
+	if office != "evening" {
+		response["morning_psalms"] = morning
+	}
+	if office != "morning" {
+		response["evening_psalms"] = evening
+	}
+
+	h.resp.WriteSuccess(w, response)
+}
+
+// GetBookReadings handles GET /api/v1/book/{book}
+//
+// Returns every date on which the book is read, with the reading type and
+// reference. Book names match whole names only ("John" excludes "1 John").
+func (h *Handlers) GetBookReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	book := scripture.NormalizeBook(r.PathValue("book"))
+	if book == "" {
+		h.resp.WriteBadRequest(w, "Book name is required")
+		return
+	}
+
+	h.logger.Debug("fetching readings for book",
+		slog.String("book", book),
+	)
+
+	matches, err := h.db.GetReadingsByBook(ctx, book)
+	if err != nil {
+		h.log(r).Error("failed to get readings by book",
+			slog.String("book", book),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	if matches == nil {
+		matches = []database.ReadingMatch{}
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"book":     book,
+		"count":    len(matches),
+		"readings": matches,
+	})
+}
+
+// SearchReadings handles GET /api/v1/search?reference=Isaiah+40
+//
+// Full-text searches the first, second, gospel, and canticle readings. Unlike
+// /where, partial references match: "Isaiah 40" finds "Isaiah 40:1-11",
+// and a bare book name finds every reading from it.
+func (h *Handlers) SearchReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reference := scripture.Normalize(r.URL.Query().Get("reference"))
+	if reference == "" {
+		h.resp.WriteBadRequest(w, "reference parameter is required")
+		return
+	}
+
+	h.logger.Debug("searching readings",
+		slog.String("reference", reference),
+	)
+
+	matches, err := h.db.SearchReadingsByReference(ctx, reference)
+	if err != nil {
+		h.log(r).Error("failed to search readings",
+			slog.String("reference", reference),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to search readings")
+		return
+	}
+
+	if matches == nil {
+		matches = []database.ReadingMatch{}
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"reference": reference,
+		"count":     len(matches),
+		"readings":  matches,
+	})
+}
+
+// GetReferencePlacement handles GET /api/v1/where?reference=John+3:1-17
+//
+// Returns every date and reading type on which the reference is appointed.
+// References are compared after normalization, so dash style and spacing
+// don't matter.
+func (h *Handlers) GetReferencePlacement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	reference := scripture.Normalize(r.URL.Query().Get("reference"))
+	if reference == "" {
+		h.resp.WriteBadRequest(w, "reference parameter is required")
+		return
+	}
+	if len(scripture.Books(reference)) == 0 {
+		h.resp.WriteBadRequest(w, "reference must start with a book and chapter, e.g. John 3:1-17")
+		return
+	}
+
+	h.logger.Debug("finding reference placement",
+		slog.String("reference", reference),
+	)
+
+	matches, err := h.db.GetReadingsByReference(ctx, reference)
+	if err != nil {
+		h.log(r).Error("failed to find reference placement",
+			slog.String("reference", reference),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	if matches == nil {
+		matches = []database.ReadingMatch{}
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"reference": reference,
+		"count":     len(matches),
+		"readings":  matches,
+	})
+}
+
+// lookupFeast finds the feast named in the {feast} path value, dated by
+// the computus from ?calendar=western|orthodox or, if absent, the
+// configured FEAST_CALENDAR. ok is false for an unknown feast; err is set
+// for an unknown calendar.
+func (h *Handlers) lookupFeast(r *http.Request, key string) (feast calendar.Feast, ok bool, err error) {
+	name := r.URL.Query().Get("calendar")
+	if name == "" {
+		name = h.cfg.FeastCalendar
+	}
+	computus, valid := calendar.ParseComputus(name)
+	if !valid {
+		return calendar.Feast{}, false, fmt.Errorf("calendar must be western or orthodox")
+	}
+
+	feast, ok = calendar.LookupFeast(key)
+	if !ok {
+		return calendar.Feast{}, false, nil
+	}
+	return feast.In(computus), true, nil
+}
+
+// relatedFeastsJSON renders a feast's related feasts for a response.
+func relatedFeastsJSON(feast calendar.Feast, year int) []map[string]string {
+	related := feast.Related(year)
+	out := make([]map[string]string, 0, len(related))
+	for _, rel := range related {
+		out = append(out, map[string]string{
+			"feast": rel.Key,
+			"name":  rel.Name,
+			"date":  rel.On.Format("2006-01-02"),
+		})
+	}
+	return out
+}
+
+// GetFeastEve handles GET /api/v1/eve/{feast}?year=YYYY
+//
+// Returns the eve (vigil) readings for a feast such as Christmas, Easter,
+// or Pentecost. The year defaults to the current calendar year. Feasts
+// without a vigil return 404. ?calendar=orthodox dates Easter and the
+// feasts that move with it by Pascha.
+func (h *Handlers) GetFeastEve(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	feast, ok, err := h.lookupFeast(r, r.PathValue("feast"))
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+	if !ok {
+		h.resp.WriteNotFound(w, "Unknown feast")
+		return
+	}
+
+	year := h.today(r).Year()
+	if yearStr := r.URL.Query().Get("year"); yearStr != "" {
+		parsed, err := parseYear(yearStr)
+		if err != nil {
+			h.resp.WriteBadRequest(w, err.Error())
+			return
+		}
+		year = parsed
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	eve, ok := feast.Eve(year)
+	if !ok {
+		h.resp.WriteNotFound(w, fmt.Sprintf("%s has no eve readings", feast.Name))
+		return
+	}
+	eveDate := eve.Format("2006-01-02")
+
+	h.logger.Debug("fetching feast eve readings",
+		slog.String("feast", feast.Key),
+		slog.String("date", eveDate),
+	)
+
+	reading, err := h.db.GetReadingByDate(ctx, eveDate)
+	if err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No readings found for %s", eveDate))
+			return
+		}
+		h.log(r).Error("failed to get feast eve readings",
+			slog.String("feast", feast.Key),
+			slog.String("date", eveDate),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"feast":      feast.Key,
+		"name":       feast.Name,
+		"feast_date": feast.Date(year).Format("2006-01-02"),
+		"eve_date":   eveDate,
+		"readings":   opts.render(reading),
+		"related":    relatedFeastsJSON(feast, year),
+	})
+}
+
+// GetSundayReadings handles GET /api/v1/sundays/{year}?liturgical=true
+//
+// Returns every Sunday of a calendar year with its readings. With
+// liturgical=true the year runs from the First Sunday of Advent in {year}
+// to the Saturday before the next Advent. Sundays without stored readings
+// are listed with null readings.
+func (h *Handlers) GetSundayReadings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	year, err := parseYear(r.PathValue("year"))
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	liturgical := false
+	if v := r.URL.Query().Get("liturgical"); v != "" {
+		liturgical, err = strconv.ParseBool(v)
+		if err != nil {
+			h.resp.WriteBadRequest(w, "liturgical must be true or false")
+			return
+		}
+	}
+
+	opts, err := parseReadingOptions(r)
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	if liturgical {
+		start, end = calendar.LiturgicalYearBounds(year)
+	}
+	startDate, endDate := start.Format("2006-01-02"), end.Format("2006-01-02")
+
+	h.logger.Debug("fetching sunday readings",
+		slog.Int("year", year),
+		slog.Bool("liturgical", liturgical),
+	)
+
+	// One range query, then pick out the Sundays
+	readings, err := h.db.GetReadingsByDateRange(ctx, startDate, endDate)
+	if err != nil {
+		h.log(r).Error("failed to get sunday readings",
+			slog.Int("year", year),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve readings")
+		return
+	}
+
+	byDate := make(map[string]*database.DailyReading, len(readings))
+	for i := range readings {
+		byDate[readings[i].Date] = &readings[i]
+	}
+
+	type sundayEntry struct {
+		Date     string           `json:"date"`
+		Readings *readingResponse `json:"readings"`
+	}
+
+	sundays := calendar.Sundays(start, end)
+	entries := make([]sundayEntry, 0, len(sundays))
+	for _, sunday := range sundays {
+		entry := sundayEntry{Date: sunday.Format("2006-01-02")}
+		if reading, ok := byDate[entry.Date]; ok {
+			rendered := opts.render(reading)
+			entry.Readings = &rendered
+		}
+		entries = append(entries, entry)
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"year":       year,
+		"liturgical": liturgical,
+		"start":      startDate,
+		"end":        endDate,
+		"count":      len(entries),
+		"sundays":    entries,
+	})
+}
+
+// GetFeastCountdown handles GET /api/v1/countdown/{feast}
+//
+// Returns the next date of the feast and the number of days until it,
+// counted from today in the request's timezone (X-Timezone header).
+// Supports ?calendar=western|orthodox like GetFeastEve.
+func (h *Handlers) GetFeastCountdown(w http.ResponseWriter, r *http.Request) {
+	feast, ok, err := h.lookupFeast(r, r.PathValue("feast"))
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+	if !ok {
+		h.resp.WriteNotFound(w, "Unknown feast")
+		return
+	}
+
+	today := h.today(r)
+	next := feast.NextOccurrence(today)
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"feast":      feast.Key,
+		"name":       feast.Name,
+		"today":      today.Format("2006-01-02"),
+		"feast_date": next.Format("2006-01-02"),
+		"days":       calendar.DaysBetween(today, next),
+		"related":    relatedFeastsJSON(feast, next.Year()),
+	})
+}
+
+// GetSpecialDay handles GET /api/v1/special-days/{name}?year=YYYY
+//
+// Resolves a feast by key or name ("christmas", "Ash Wednesday") to its
+// date in a liturgical year. year is the calendar year in which the
+// liturgical year begins at Advent, as for seasons; it defaults to the
+// liturgical year containing today. Supports ?calendar=western|orthodox.
+func (h *Handlers) GetSpecialDay(w http.ResponseWriter, r *http.Request) {
+	feast, ok, err := h.lookupFeast(r, r.PathValue("name"))
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+	if !ok {
+		h.resp.WriteNotFound(w, "Unknown special day")
+		return
+	}
+
+	year := calendar.LiturgicalYearOf(h.today(r))
+	if yearStr := r.URL.Query().Get("year"); yearStr != "" {
+		parsed, err := parseYear(yearStr)
+		if err != nil {
+			h.resp.WriteBadRequest(w, err.Error())
+			return
+		}
+		year = parsed
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"feast":           feast.Key,
+		"name":            feast.Name,
+		"movable":         feast.Movable,
+		"liturgical_year": year,
+		"date":            feast.InLiturgicalYear(year).Format("2006-01-02"),
+	})
+}
+
+// keyDates are the liturgical anchor dates of a calendar year.
+type keyDates struct {
+	Year             int    `json:"year"`
+	Epiphany         string `json:"epiphany"`
+	BaptismOfTheLord string `json:"baptism_of_the_lord"`
+	AshWednesday     string `json:"ash_wednesday"`
+	PalmSunday       string `json:"palm_sunday"`
+	Easter           string `json:"easter"`
+	Ascension        string `json:"ascension"`
+	Pentecost        string `json:"pentecost"`
+	TrinitySunday    string `json:"trinity_sunday"`
+	ChristTheKing    string `json:"christ_the_king"`
+	Advent           string `json:"advent"`
+	Christmas        string `json:"christmas"`
+}
+
+// GetKeyDates handles GET /api/v1/calendar/{year}
+//
+// Returns the liturgical anchor dates falling in a calendar year, so
+// clients don't have to reimplement the Easter and Advent calculations.
+// Advent and Christmas are those at the end of the year.
+func (h *Handlers) GetKeyDates(w http.ResponseWriter, r *http.Request) {
+	year, err := parseYear(r.PathValue("year"))
+	if err != nil {
+		h.resp.WriteBadRequest(w, err.Error())
+		return
+	}
+
+	format := func(t time.Time) string { return t.Format("2006-01-02") }
+	kd := calendar.KeyDatesFor(year)
+
+	h.resp.WriteSuccess(w, keyDates{
+		Year:             year,
+		Epiphany:         format(time.Date(year, time.January, 6, 0, 0, 0, 0, time.UTC)),
+		BaptismOfTheLord: format(kd.BaptismOfTheLord),
+		AshWednesday:     format(kd.AshWednesday),
+		PalmSunday:       format(kd.PalmSunday),
+		Easter:           format(kd.Easter),
+		Ascension:        format(kd.Ascension),
+		Pentecost:        format(kd.Pentecost),
+		TrinitySunday:    format(kd.TrinitySunday),
+		ChristTheKing:    format(kd.ChristTheKing),
+		Advent:           format(kd.Advent),
+		Christmas:        format(time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC)),
+	})
+}
+
+// Replace the progress endpoint placeholders in handlers.go with these implementations
+
+// =============================================================================
+// Progress Endpoints (Fully Implemented)
+// =============================================================================
+
+// GetProgress handles GET /api/v1/progress
+// Returns paginated list of completed readings for the authenticated user.
+// Query params: limit (default 50, max 100), offset (default 0),
+// from/to (YYYY-MM-DD, inclusive) to filter by completion date
+func (h *Handlers) GetProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	// Parse pagination parameters
+	limit := 50 // default
+	offset := 0 // default
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsed, err := strconv.Atoi(limitStr); err == nil {
+			if parsed > 0 && parsed <= 100 {
+				limit = parsed
+			}
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsed, err := strconv.Atoi(offsetStr); err == nil {
+			if parsed >= 0 {
+				offset = parsed
+			}
+		}
+	}
+
+	// Optional completion date filter
+	from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+	for _, param := range []struct{ name, value string }{{"from", from}, {"to", to}} {
+		if param.value == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", param.value); err != nil {
+			h.resp.WriteBadRequest(w, fmt.Sprintf("Invalid %s date. Use YYYY-MM-DD", param.name))
+			return
+		}
+	}
+	if from != "" && to != "" && from > to {
+		h.resp.WriteBadRequest(w, "from must be on or before to")
+		return
+	}
+	filtered := from != "" || to != ""
+
+	h.logger.Debug("fetching user progress",
+		slog.String("user_id", userID),
+		slog.Int("limit", limit),
+		slog.Int("offset", offset),
+		slog.String("from", from),
+		slog.String("to", to),
+	)
+
+	// Fetch progress from database
+	var progress []database.ReadingProgress
+	var err error
+	if filtered {
+		progress, err = h.db.GetProgressByUserInRange(ctx, userID, from, to, limit, offset)
+	} else {
+		progress, err = h.db.GetProgressByUser(ctx, userID, limit, offset)
+	}
+	if err != nil {
+		h.log(r).Error("failed to get progress",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve progress")
+		return
+	}
+
+	data := map[string]interface{}{
+		"progress": progress,
+		"limit":    limit,
+		"offset":   offset,
+		"count":    len(progress),
+	}
+	if from != "" {
+		data["from"] = from
+	}
+	if to != "" {
+		data["to"] = to
+	}
+	h.resp.WriteSuccess(w, data)
+}
+
+// CreateProgress handles POST /api/v1/progress
+// Marks a reading as completed for the authenticated user.
+// Request body: {"date": "YYYY-MM-DD", "notes": "optional notes"}
+func (h *Handlers) CreateProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	// Parse request body
+	var req struct {
+		Date  string `json:"date"`
+		Notes string `json:"notes,omitempty"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid request body")
+		return
+	}
+
+	// Validate date format
+	_, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
+		return
+	}
+
+	h.logger.Debug("creating progress entry",
+		slog.String("user_id", userID),
+		slog.String("date", req.Date),
+	)
+
+	// Check if reading exists for this date
+	_, err = h.db.GetReadingByDate(ctx, req.Date)
+	if err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No reading found for %s", req.Date))
+			return
+		}
+		h.log(r).Error("failed to verify reading exists",
+			slog.String("date", req.Date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to verify reading")
+		return
+	}
+
+	// Create progress entry
+	var notes *string
+	if req.Notes != "" {
+		notes = &req.Notes
+	}
+
+	progress := &database.ReadingProgress{
+		UserID:      userID,
+		ReadingDate: req.Date,
+		Notes:       notes,
+		CompletedAt: time.Now(),
+	}
+
+	if err := h.db.CreateProgress(ctx, progress); err != nil {
+		if err == database.ErrDuplicate {
+			h.resp.WriteConflict(w, fmt.Sprintf("Reading for %s already marked as complete", req.Date))
+			return
+		}
+		h.log(r).Error("failed to create progress",
+			slog.String("user_id", userID),
+			slog.String("date", req.Date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to mark reading as complete")
+		return
+	}
+
+	h.logger.Info("progress created",
+		slog.String("user_id", userID),
+		slog.String("date", req.Date),
+	)
+
+	h.resp.WriteSuccess(w, progress)
+}
+
+// MarkDayComplete handles POST /api/v1/progress/day
+// Marks a day's readings complete for the authenticated user. Unlike
+// CreateProgress it is idempotent: a day already marked is left as is and
+// reported with newly_marked 0 instead of a 409.
+func (h *Handlers) MarkDayComplete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	var req struct {
+		Date  string `json:"date"`
+		Notes string `json:"notes,omitempty"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid request body")
+		return
+	}
+
+	if _, err := time.Parse("2006-01-02", req.Date); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
+		return
+	}
+
+	if _, err := h.db.GetReadingByDate(ctx, req.Date); err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No reading found for %s", req.Date))
+			return
+		}
+		h.log(r).Error("failed to verify reading exists",
+			slog.String("date", req.Date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to verify reading")
+		return
+	}
+
+	var notes *string
+	if req.Notes != "" {
+		notes = &req.Notes
+	}
+
+	inserted, err := h.db.MarkDayComplete(ctx, &database.ReadingProgress{
+		UserID:      userID,
+		ReadingDate: req.Date,
+		Notes:       notes,
+		CompletedAt: time.Now(),
+	})
+	if err != nil {
+		h.log(r).Error("failed to mark day complete",
+			slog.String("user_id", userID),
+			slog.String("date", req.Date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to mark day as complete")
+		return
+	}
+
+	newlyMarked := 0
+	if inserted {
+		newlyMarked = 1
+	}
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"date":         req.Date,
+		"newly_marked": newlyMarked,
+	})
+}
+
+// DeleteProgress handles DELETE /api/v1/progress/{id}
+// Removes a completed reading for the authenticated user.
+// Path parameter {id} is actually the date (YYYY-MM-DD)
+func (h *Handlers) DeleteProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	// Get date from path parameter
+	// Note: routes.go uses {id} but we're treating it as a date
+	date := r.PathValue("id")
+	if date == "" {
+		h.resp.WriteBadRequest(w, "Date parameter is required")
+		return
+	}
+
+	// Validate date format
+	_, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
+		return
+	}
+
+	h.logger.Debug("deleting progress entry",
+		slog.String("user_id", userID),
+		slog.String("date", date),
+	)
+
+	// Delete progress entry
+	if err := h.db.DeleteProgress(ctx, userID, date); err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No completed reading found for %s", date))
+			return
+		}
+		h.log(r).Error("failed to delete progress",
+			slog.String("user_id", userID),
+			slog.String("date", date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to delete progress")
+		return
+	}
+
+	h.logger.Info("progress deleted",
+		slog.String("user_id", userID),
+		slog.String("date", date),
+	)
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"message": "Progress entry deleted",
+		"date":    date,
+	})
+}
+
+// ResetProgress handles DELETE /api/v1/progress?confirm=true
+// Deletes all of the authenticated user's progress, e.g. when starting a
+// new reading year. The confirm parameter guards against accidental wipes.
+func (h *Handlers) ResetProgress(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	if confirm, _ := strconv.ParseBool(r.URL.Query().Get("confirm")); !confirm {
+		h.resp.WriteBadRequest(w, "This deletes all of your progress. Add ?confirm=true to proceed")
+		return
+	}
+
+	deleted, err := h.db.DeleteAllProgressForUser(ctx, userID)
+	if err != nil {
+		h.log(r).Error("failed to reset progress",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to reset progress")
+		return
+	}
+
+	h.logger.Info("progress reset",
+		slog.String("user_id", userID),
+		slog.Int64("deleted", deleted),
+	)
+
+	h.resp.WriteSuccess(w, map[string]interface{}{
+		"message": "Progress reset",
+		"deleted": deleted,
+	})
+}
+
+// UpdateProgressNotes handles PATCH /api/v1/progress/{id}
+// Edits the notes on a completed reading for the authenticated user
+// without touching completed_at. As with DeleteProgress, {id} is the date
+// (YYYY-MM-DD). An empty notes string clears them.
+func (h *Handlers) UpdateProgressNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	date := r.PathValue("id")
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
+		return
+	}
+
+	var req struct {
+		Notes *string `json:"notes"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid request body")
+		return
+	}
+	if req.Notes == nil {
+		h.resp.WriteBadRequest(w, "notes is required; send \"\" to clear them")
+		return
+	}
+
+	if err := h.db.UpdateProgressNotes(ctx, userID, date, req.Notes); err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, fmt.Sprintf("No completed reading found for %s", date))
+			return
+		}
+		h.log(r).Error("failed to update progress notes",
+			slog.String("user_id", userID),
+			slog.String("date", date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to update notes")
+		return
+	}
+
+	progress, err := h.db.GetProgressByDate(ctx, userID, date)
+	if err != nil {
+		h.log(r).Error("failed to get updated progress",
+			slog.String("user_id", userID),
+			slog.String("date", date),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve updated progress")
+		return
+	}
+
+	h.resp.WriteSuccess(w, progress)
+}
+
+// GetProgressStats handles GET /api/v1/progress/stats
+// Returns reading statistics for the authenticated user.
+// Includes: total days, completed days, completion %, current streak, longest streak
+func (h *Handlers) GetProgressStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	userID := GetUserID(r)
+
+	h.logger.Debug("fetching progress stats",
+		slog.String("user_id", userID),
+	)
+
+	// Get statistics from database
+	stats, err := h.db.GetProgressStats(ctx, userID)
+	if err != nil {
+		h.log(r).Error("failed to get progress stats",
+			slog.String("user_id", userID),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve statistics")
+		return
+	}
+
+	h.resp.WriteSuccess(w, stats)
 }
 
-// CreateProgress handles POST /api/v1/progress
-// Marks a reading as completed for the authenticated user.
-// Request body: {"date": "YYYY-MM-DD", "notes": "optional notes"}
-func (h *Handlers) CreateProgress(w http.ResponseWriter, r *http.Request) {
+// maxPlanDays caps a reading plan's length at the two-year cycle.
+const maxPlanDays = 730
+
+// CreateReadingPlan handles POST /api/v1/plans
+// Creates a reading plan for the authenticated user: a goal to complete
+// every day for length_days days from start_date (default today). "days"
+// is accepted as an alias for length_days.
+func (h *Handlers) CreateReadingPlan(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	userID := GetUserID(r)
 
-	// Parse request body
 	var req struct {
-		Date  string `json:"date"`
-		Notes string `json:"notes,omitempty"`
+		Name       string `json:"name,omitempty"`
+		StartDate  string `json:"start_date,omitempty"`
+		LengthDays int    `json:"length_days"`
+		Days       int    `json:"days,omitempty"` // Alias for length_days
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -287,144 +2223,88 @@ func (h *Handlers) CreateProgress(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate date format
-	_, err := time.Parse("2006-01-02", req.Date)
-	if err != nil {
-		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
+	if req.StartDate == "" {
+		req.StartDate = h.today(r).Format("2006-01-02")
+	} else if _, err := parseDate(req.StartDate); err != nil {
+		h.resp.WriteBadRequest(w, dateError(err, "Invalid start_date format. Use YYYY-MM-DD"))
 		return
 	}
 
-	h.logger.Debug("creating progress entry",
-		slog.String("user_id", userID),
-		slog.String("date", req.Date),
-	)
-
-	// Check if reading exists for this date
-	_, err = h.db.GetReadingByDate(ctx, req.Date)
-	if err != nil {
-		if database.IsNotFound(err) {
-			h.resp.WriteNotFound(w, fmt.Sprintf("No reading found for %s", req.Date))
-			return
-		}
-		h.logger.Error("failed to verify reading exists",
-			slog.String("date", req.Date),
-			slog.String("error", err.Error()),
-		)
-		h.resp.WriteInternalError(w, "Failed to verify reading")
-		return
+	if req.LengthDays == 0 {
+		req.LengthDays = req.Days
 	}
-
-	// Create progress entry
-	var notes *string
-	if req.Notes != "" {
-		notes = &req.Notes
+	if req.LengthDays < 1 || req.LengthDays > maxPlanDays {
+		h.resp.WriteBadRequest(w, fmt.Sprintf("length_days must be from 1 to %d", maxPlanDays))
+		return
 	}
 
-	progress := &database.ReadingProgress{
-		UserID:      userID,
-		ReadingDate: req.Date,
-		Notes:       notes,
-		CompletedAt: time.Now(),
+	plan := &database.ReadingPlan{
+		UserID:     userID,
+		Name:       req.Name,
+		StartDate:  req.StartDate,
+		LengthDays: req.LengthDays,
 	}
-
-	if err := h.db.CreateProgress(ctx, progress); err != nil {
-		if err == database.ErrDuplicate {
-			h.resp.WriteConflict(w, fmt.Sprintf("Reading for %s already marked as complete", req.Date))
-			return
-		}
-		h.logger.Error("failed to create progress",
+	if err := h.db.CreateReadingPlan(ctx, plan); err != nil {
+		h.log(r).Error("failed to create reading plan",
 			slog.String("user_id", userID),
-			slog.String("date", req.Date),
 			slog.String("error", err.Error()),
 		)
-		h.resp.WriteInternalError(w, "Failed to mark reading as complete")
+		h.resp.WriteInternalError(w, "Failed to create reading plan")
 		return
 	}
 
-	h.logger.Info("progress created",
+	h.logger.Info("reading plan created",
 		slog.String("user_id", userID),
-		slog.String("date", req.Date),
+		slog.Int64("plan_id", plan.ID),
 	)
 
-	h.resp.WriteSuccess(w, progress)
+	h.writePlanProgress(w, r, plan)
 }
 
-// DeleteProgress handles DELETE /api/v1/progress/{id}
-// Removes a completed reading for the authenticated user.
-// Path parameter {id} is actually the date (YYYY-MM-DD)
-func (h *Handlers) DeleteProgress(w http.ResponseWriter, r *http.Request) {
+// GetReadingPlan handles GET /api/v1/plans/{id}
+// Returns one of the authenticated user's plans with its completion
+// percentage, computed from progress over the plan's date range.
+func (h *Handlers) GetReadingPlan(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	userID := GetUserID(r)
 
-	// Get date from path parameter
-	// Note: routes.go uses {id} but we're treating it as a date
-	date := r.PathValue("id")
-	if date == "" {
-		h.resp.WriteBadRequest(w, "Date parameter is required")
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id < 1 {
+		h.resp.WriteBadRequest(w, "Invalid plan ID")
 		return
 	}
 
-	// Validate date format
-	_, err := time.Parse("2006-01-02", date)
+	plan, err := h.db.GetReadingPlan(ctx, userID, id)
 	if err != nil {
-		h.resp.WriteBadRequest(w, "Invalid date format. Use YYYY-MM-DD")
-		return
-	}
-
-	h.logger.Debug("deleting progress entry",
-		slog.String("user_id", userID),
-		slog.String("date", date),
-	)
-
-	// Delete progress entry
-	if err := h.db.DeleteProgress(ctx, userID, date); err != nil {
 		if database.IsNotFound(err) {
-			h.resp.WriteNotFound(w, fmt.Sprintf("No completed reading found for %s", date))
+			h.resp.WriteNotFound(w, fmt.Sprintf("No reading plan with ID %d", id))
 			return
 		}
-		h.logger.Error("failed to delete progress",
+		h.log(r).Error("failed to get reading plan",
 			slog.String("user_id", userID),
-			slog.String("date", date),
+			slog.Int64("plan_id", id),
 			slog.String("error", err.Error()),
 		)
-		h.resp.WriteInternalError(w, "Failed to delete progress")
+		h.resp.WriteInternalError(w, "Failed to retrieve reading plan")
 		return
 	}
 
-	h.logger.Info("progress deleted",
-		slog.String("user_id", userID),
-		slog.String("date", date),
-	)
-
-	h.resp.WriteSuccess(w, map[string]interface{}{
-		"message": "Progress entry deleted",
-		"date":    date,
-	})
+	h.writePlanProgress(w, r, plan)
 }
 
-// GetProgressStats handles GET /api/v1/progress/stats
-// Returns reading statistics for the authenticated user.
-// Includes: total days, completed days, completion %, current streak, longest streak
-func (h *Handlers) GetProgressStats(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-	userID := GetUserID(r)
-
-	h.logger.Debug("fetching progress stats",
-		slog.String("user_id", userID),
-	)
-
-	// Get statistics from database
-	stats, err := h.db.GetProgressStats(ctx, userID)
+// writePlanProgress writes a plan with its computed completion.
+func (h *Handlers) writePlanProgress(w http.ResponseWriter, r *http.Request, plan *database.ReadingPlan) {
+	progress, err := h.db.GetPlanProgress(r.Context(), plan)
 	if err != nil {
-		h.logger.Error("failed to get progress stats",
-			slog.String("user_id", userID),
+		h.log(r).Error("failed to compute plan progress",
+			slog.Int64("plan_id", plan.ID),
 			slog.String("error", err.Error()),
 		)
-		h.resp.WriteInternalError(w, "Failed to retrieve statistics")
+		h.resp.WriteInternalError(w, "Failed to compute plan progress")
 		return
 	}
 
-	h.resp.WriteSuccess(w, stats)
+	h.resp.WriteSuccess(w, progress)
 }
 
 // CreateUser handles POST /api/v1/admin/users (admin only)
@@ -453,7 +2333,7 @@ func (h *Handlers) CreateUser(w http.ResponseWriter, r *http.Request) {
 			h.resp.WriteConflict(w, "Username already exists")
 			return
 		}
-		h.logger.Error("failed to create user",
+		h.log(r).Error("failed to create user",
 			slog.String("username", req.Username),
 			slog.String("error", err.Error()),
 		)
@@ -482,7 +2362,8 @@ func (h *Handlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		Name string `json:"name"`
+		Name          string `json:"name"`
+		ExpiresInDays int    `json:"expires_in_days"` // Optional; 0 never expires
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -494,10 +2375,15 @@ func (h *Handlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 		h.resp.WriteBadRequest(w, "name is required")
 		return
 	}
+	if req.ExpiresInDays < 0 {
+		h.resp.WriteBadRequest(w, "expires_in_days must not be negative")
+		return
+	}
 
-	keyWithPlaintext, err := h.db.CreateAPIKey(ctx, userID, req.Name)
+	ttl := time.Duration(req.ExpiresInDays) * 24 * time.Hour
+	keyWithPlaintext, err := h.db.CreateAPIKey(ctx, userID, req.Name, ttl)
 	if err != nil {
-		h.logger.Error("failed to create api key",
+		h.log(r).Error("failed to create api key",
 			slog.Int64("user_id", userID),
 			slog.String("error", err.Error()),
 		)
@@ -521,9 +2407,9 @@ func (h *Handlers) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
 func (h *Handlers) ListUsers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	users, err := h.db.ListUsers(ctx)
+	users, err := h.db.ListUsersWithKeys(ctx)
 	if err != nil {
-		h.logger.Error("failed to list users",
+		h.log(r).Error("failed to list users",
 			slog.String("error", err.Error()),
 		)
 		h.resp.WriteInternalError(w, "Failed to list users")
@@ -560,7 +2446,7 @@ func (h *Handlers) GetMyAPIKeys(w http.ResponseWriter, r *http.Request) {
 
 	keys, err := h.db.ListUserAPIKeys(ctx, user.ID)
 	if err != nil {
-		h.logger.Error("failed to list user api keys",
+		h.log(r).Error("failed to list user api keys",
 			slog.Int64("user_id", user.ID),
 			slog.String("error", err.Error()),
 		)
@@ -596,7 +2482,7 @@ func (h *Handlers) RevokeMyAPIKey(w http.ResponseWriter, r *http.Request) {
 			h.resp.WriteNotFound(w, "API key not found")
 			return
 		}
-		h.logger.Error("failed to revoke api key",
+		h.log(r).Error("failed to revoke api key",
 			slog.Int64("user_id", user.ID),
 			slog.Int64("key_id", keyID),
 			slog.String("error", err.Error()),
@@ -614,3 +2500,281 @@ func (h *Handlers) RevokeMyAPIKey(w http.ResponseWriter, r *http.Request) {
 		"message": "API key revoked successfully",
 	})
 }
+
+// =============================================================================
+// Admin Data Endpoints
+// =============================================================================
+
+// GetSnapshot handles GET /api/v1/admin/snapshot.db.gz (admin only)
+// Streams a gzip-compressed, readings-only copy of the SQLite database for
+// offline clients. Users, API keys, progress, and plans are left out (see
+// database.Snapshot).
+func (h *Handlers) GetSnapshot(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	tmpDir, err := os.MkdirTemp("", "lectionary-snapshot-*")
+	if err != nil {
+		h.log(r).Error("failed to create snapshot directory",
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to create snapshot")
+		return
+	}
+	defer os.RemoveAll(tmpDir)
+
+	snapshotPath := filepath.Join(tmpDir, "lectionary.db")
+	if err := h.db.Snapshot(ctx, snapshotPath); err != nil {
+		h.log(r).Error("failed to snapshot database",
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to create snapshot")
+		return
+	}
+
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		h.log(r).Error("failed to open snapshot",
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to create snapshot")
+		return
+	}
+	defer f.Close()
+
+	filename := fmt.Sprintf("lectionary-%s.db.gz", time.Now().UTC().Format("20060102"))
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	// Headers are sent at this point, so failures can only be logged
+	gz := gzip.NewWriter(w)
+	if _, err := io.Copy(gz, f); err != nil {
+		h.log(r).Error("failed to stream snapshot",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	if err := gz.Close(); err != nil {
+		h.log(r).Error("failed to finish snapshot stream",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	h.logger.Info("database snapshot downloaded",
+		slog.String("filename", filename),
+	)
+}
+
+// GetCompleteness handles GET /api/v1/admin/completeness (admin only)
+//
+// Returns data-quality percentages for the stored readings, useful as a
+// quick check after an import.
+func (h *Handlers) GetCompleteness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	stats, err := h.db.GetCompletenessStats(ctx)
+	if err != nil {
+		h.log(r).Error("failed to get completeness stats",
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to compute completeness")
+		return
+	}
+
+	h.resp.WriteSuccess(w, stats)
+}
+
+// SetMaintenance handles POST /api/v1/admin/maintenance (admin only)
+//
+// Body: {"enabled": true, "message": "Importing 2026 data", "duration_minutes": 30}
+// While enabled, readings endpoints return 503 with Retry-After. A positive
+// duration_minutes turns maintenance off automatically after that long.
+func (h *Handlers) SetMaintenance(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Enabled         bool   `json:"enabled"`
+		Message         string `json:"message,omitempty"`
+		DurationMinutes int    `json:"duration_minutes,omitempty"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid request body")
+		return
+	}
+
+	if req.DurationMinutes < 0 {
+		h.resp.WriteBadRequest(w, "duration_minutes must not be negative")
+		return
+	}
+
+	if req.Enabled {
+		h.maintenance.Enable(req.Message, time.Duration(req.DurationMinutes)*time.Minute)
+	} else {
+		h.maintenance.Disable()
+	}
+
+	active, message, until := h.maintenance.Status()
+
+	h.logger.Info("maintenance mode changed",
+		slog.Bool("enabled", active),
+		slog.String("message", message),
+	)
+
+	response := map[string]interface{}{
+		"enabled": active,
+		"message": message,
+	}
+	if !until.IsZero() {
+		response["until"] = until.UTC().Format(time.RFC3339)
+	}
+
+	h.resp.WriteSuccess(w, response)
+}
+
+// UpdateReading handles PATCH /api/v1/admin/readings/{id} (admin only)
+//
+// Corrects one scripture reference of a stored day, e.g. a typo, without
+// rebuilding the database. Body: {"reading_type": "gospel", "reference": "John 3:1-17"}
+func (h *Handlers) UpdateReading(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id < 1 {
+		h.resp.WriteBadRequest(w, "Invalid reading ID")
+		return
+	}
+
+	var req struct {
+		ReadingType database.ReadingType `json:"reading_type"`
+		Reference   string               `json:"reference"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.resp.WriteBadRequest(w, "Invalid request body")
+		return
+	}
+
+	if !slices.Contains(database.ValidReadingTypes, req.ReadingType) {
+		h.resp.WriteBadRequest(w, "reading_type must be one of: first, second, gospel, canticle")
+		return
+	}
+
+	reference := strings.TrimSpace(req.Reference)
+	if reference == "" {
+		h.resp.WriteBadRequest(w, "reference is required")
+		return
+	}
+
+	if err := h.db.UpdateReading(ctx, id, req.ReadingType, reference); err != nil {
+		if database.IsNotFound(err) {
+			h.resp.WriteNotFound(w, "Reading not found")
+			return
+		}
+		h.log(r).Error("failed to update reading",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to update reading")
+		return
+	}
+
+	reading, err := h.db.GetReadingByID(ctx, id)
+	if err != nil {
+		h.log(r).Error("failed to get updated reading",
+			slog.Int64("id", id),
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to retrieve reading")
+		return
+	}
+
+	h.logger.Info("reading updated",
+		slog.Int64("id", id),
+		slog.String("date", reading.Date),
+		slog.String("reading_type", string(req.ReadingType)),
+		slog.String("reference", reference),
+	)
+
+	h.resp.WriteSuccess(w, reading)
+}
+
+// maxPreflightBody caps the import JSON accepted by ImportPreflight.
+// A full three-year scrape is a few megabytes.
+const maxPreflightBody = 32 << 20
+
+// maxPreflightYears caps the year range a single preflight checks.
+const maxPreflightYears = 10
+
+// ImportPreflight handles POST /api/v1/admin/import/preflight (admin only)
+//
+// Accepts scraper JSON (the same file cmd/import reads), imports it into a
+// temporary in-memory database, and reports every date in the year range
+// that would have no readings or incomplete readings. The real database is
+// not touched. Query params: start_year and end_year (default to the years
+// the dataset covers, or the current year if it is empty).
+func (h *Handlers) ImportPreflight(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPreflightBody))
+	if err != nil {
+		h.resp.WriteBadRequest(w, "Request body too large or unreadable")
+		return
+	}
+
+	data, err := importer.Parse(body)
+	if err != nil {
+		h.resp.WriteBadRequest(w, "Invalid import JSON")
+		return
+	}
+
+	startYear, endYear := h.today(r).Year(), h.today(r).Year()
+	if dates := data.Dates(); len(dates) > 0 {
+		first, errFirst := time.Parse("2006-01-02", dates[0])
+		last, errLast := time.Parse("2006-01-02", dates[len(dates)-1])
+		if errFirst == nil && errLast == nil {
+			startYear, endYear = first.Year(), last.Year()
+		}
+	}
+
+	if v := r.URL.Query().Get("start_year"); v != "" {
+		if startYear, err = parseYear(v); err != nil {
+			h.resp.WriteBadRequest(w, "Invalid start_year: "+err.Error())
+			return
+		}
+	}
+	if v := r.URL.Query().Get("end_year"); v != "" {
+		if endYear, err = parseYear(v); err != nil {
+			h.resp.WriteBadRequest(w, "Invalid end_year: "+err.Error())
+			return
+		}
+	}
+
+	if endYear < startYear {
+		h.resp.WriteBadRequest(w, "end_year must not be before start_year")
+		return
+	}
+	if endYear-startYear+1 > maxPreflightYears {
+		h.resp.WriteBadRequest(w, fmt.Sprintf("Year range cannot exceed %d years", maxPreflightYears))
+		return
+	}
+
+	start := time.Date(startYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(endYear, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	report, err := importer.Preflight(ctx, data, start, end, h.logger)
+	if err != nil {
+		h.log(r).Error("import preflight failed",
+			slog.String("error", err.Error()),
+		)
+		h.resp.WriteInternalError(w, "Failed to run import preflight")
+		return
+	}
+
+	h.logger.Info("import preflight complete",
+		slog.Int("dates_in_file", report.DatesInFile),
+		slog.Int("missing", len(report.MissingDates)),
+		slog.Int("incomplete", len(report.IncompleteDates)),
+	)
+
+	h.resp.WriteSuccess(w, report)
+}
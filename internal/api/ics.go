@@ -0,0 +1,82 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icsEvent is an all-day iCalendar event.
+type icsEvent struct {
+	UID         string
+	Date        time.Time
+	Stamp       time.Time
+	Summary     string
+	Description string
+}
+
+// icsEscaper escapes TEXT values per RFC 5545 section 3.3.11.
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\n", `\n`,
+)
+
+// writeICS writes a VCALENDAR containing the events. Lines end in CRLF and
+// are folded at 75 octets as RFC 5545 requires.
+func writeICS(w io.Writer, name string, events []icsEvent) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Lectionary API//Daily Lectionary//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + icsEscaper.Replace(name),
+	}
+
+	for _, e := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+e.UID,
+			"DTSTAMP:"+e.Stamp.UTC().Format("20060102T150405Z"),
+			"DTSTART;VALUE=DATE:"+e.Date.Format("20060102"),
+			"DTEND;VALUE=DATE:"+e.Date.AddDate(0, 0, 1).Format("20060102"),
+			"SUMMARY:"+icsEscaper.Replace(e.Summary),
+			"DESCRIPTION:"+icsEscaper.Replace(e.Description),
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(w, foldICSLine(line)+"\r\n"); err != nil {
+			return fmt.Errorf("write calendar: %w", err)
+		}
+	}
+	return nil
+}
+
+// foldICSLine splits a content line into 75-octet pieces joined by CRLF
+// and a space, without breaking UTF-8 sequences.
+func foldICSLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1 // The leading space counts toward the next line
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
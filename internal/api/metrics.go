@@ -0,0 +1,177 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// durationBuckets are the upper bounds, in seconds, of the request duration
+// histogram. They follow the Prometheus client defaults.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// unmatchedRoute labels requests that matched no route, so probes for
+// random paths can't create unbounded label values.
+const unmatchedRoute = "unmatched"
+
+// Metrics collects HTTP request counts and latencies and serves them in the
+// Prometheus text exposition format. Safe for concurrent use.
+type Metrics struct {
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	durations map[routeKey]*histogram
+}
+
+// requestKey identifies a request counter series.
+type requestKey struct {
+	method string
+	route  string
+	status int
+}
+
+// routeKey identifies a duration histogram series.
+type routeKey struct {
+	method string
+	route  string
+}
+
+type histogram struct {
+	counts []uint64 // Per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+// NewMetrics creates an empty metrics collector.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requests:  make(map[requestKey]uint64),
+		durations: make(map[routeKey]*histogram),
+	}
+}
+
+// Observe records one finished request.
+func (m *Metrics) Observe(method, route string, status int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[requestKey{method, route, status}]++
+
+	key := routeKey{method, route}
+	h, ok := m.durations[key]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(durationBuckets))}
+		m.durations[key] = h
+	}
+
+	seconds := duration.Seconds()
+	for i, bound := range durationBuckets {
+		if seconds <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// ServeHTTP writes the collected metrics in the Prometheus text format.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	fmt.Fprint(w, m.String())
+}
+
+// String renders the metrics in the Prometheus text format, with series
+// sorted so the output is stable between scrapes.
+func (m *Metrics) String() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+
+	requestKeys := make([]requestKey, 0, len(m.requests))
+	for k := range m.requests {
+		requestKeys = append(requestKeys, k)
+	}
+	sort.Slice(requestKeys, func(i, j int) bool {
+		a, c := requestKeys[i], requestKeys[j]
+		if a.route != c.route {
+			return a.route < c.route
+		}
+		if a.method != c.method {
+			return a.method < c.method
+		}
+		return a.status < c.status
+	})
+
+	b.WriteString("# HELP http_requests_total Total HTTP requests by method, route, and status.\n")
+	b.WriteString("# TYPE http_requests_total counter\n")
+	for _, k := range requestKeys {
+		fmt.Fprintf(&b, "http_requests_total{method=%q,route=%q,status=\"%d\"} %d\n",
+			k.method, k.route, k.status, m.requests[k])
+	}
+
+	routeKeys := make([]routeKey, 0, len(m.durations))
+	for k := range m.durations {
+		routeKeys = append(routeKeys, k)
+	}
+	sort.Slice(routeKeys, func(i, j int) bool {
+		a, c := routeKeys[i], routeKeys[j]
+		if a.route != c.route {
+			return a.route < c.route
+		}
+		return a.method < c.method
+	})
+
+	b.WriteString("# HELP http_request_duration_seconds HTTP request latency by method and route.\n")
+	b.WriteString("# TYPE http_request_duration_seconds histogram\n")
+	for _, k := range routeKeys {
+		h := m.durations[k]
+		labels := fmt.Sprintf("method=%q,route=%q", k.method, k.route)
+
+		var cumulative uint64
+		for i, bound := range durationBuckets {
+			cumulative += h.counts[i]
+			fmt.Fprintf(&b, "http_request_duration_seconds_bucket{%s,le=\"%s\"} %d\n",
+				labels, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+		}
+		fmt.Fprintf(&b, "http_request_duration_seconds_bucket{%s,le=\"+Inf\"} %d\n", labels, h.count)
+		fmt.Fprintf(&b, "http_request_duration_seconds_sum{%s} %s\n", labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "http_request_duration_seconds_count{%s} %d\n", labels, h.count)
+	}
+
+	return b.String()
+}
+
+// MetricsMiddleware records each request's method, route, status, and
+// duration in m. The route is the mux pattern that matched (for example
+// "/api/v1/readings/{id}"), so it must wrap the ServeMux itself and the
+// request must reach the mux unchanged.
+func MetricsMiddleware(m *Metrics) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			wrapped := &statusResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+			next.ServeHTTP(wrapped, r)
+
+			m.Observe(r.Method, routeLabel(r.Pattern), wrapped.statusCode, time.Since(start))
+		})
+	}
+}
+
+// routeLabel turns a mux pattern such as "GET /api/v1/readings/{id}" into
+// the route label "/api/v1/readings/{id}"; the method is its own label.
+func routeLabel(pattern string) string {
+	if pattern == "" {
+		return unmatchedRoute
+	}
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		return path
+	}
+	return pattern
+}
@@ -3,14 +3,24 @@ package api
 import (
 	"context"
 	"crypto/rand"
+	"crypto/subtle"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"mime"
+	"net"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/zapponejosh/lectionary-api/internal/calendar"
 	"github.com/zapponejosh/lectionary-api/internal/config"
 	"github.com/zapponejosh/lectionary-api/internal/database"
+	applog "github.com/zapponejosh/lectionary-api/internal/logger"
 )
 
 // Middleware is a function that wraps an HTTP handler.
@@ -34,15 +44,23 @@ func ChainMiddleware(middlewares ...Middleware) Middleware {
 	}
 }
 
+// maxRequestIDLength caps client-supplied request IDs.
+const maxRequestIDLength = 128
+
 // RequestIDMiddleware adds a unique request ID to each request.
-// The ID is added to both the request header and response header as X-Request-ID.
+// The ID is stored in the request context (read it with logger.RequestID)
+// and sent back in the X-Request-ID response header.
+// A well-formed X-Request-ID from the client is kept so logs can be
+// correlated across services; otherwise a new ID is generated.
 func RequestIDMiddleware() Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			requestID := generateRequestID()
-			r.Header.Set("X-Request-ID", requestID)
+			requestID := r.Header.Get("X-Request-ID")
+			if !validRequestID(requestID) {
+				requestID = generateRequestID()
+			}
 			w.Header().Set("X-Request-ID", requestID)
-			next.ServeHTTP(w, r)
+			next.ServeHTTP(w, r.WithContext(applog.WithRequestID(r.Context(), requestID)))
 		})
 	}
 }
@@ -67,7 +85,7 @@ func LoggingMiddleware(logger *slog.Logger) Middleware {
 				slog.String("remote_addr", r.RemoteAddr),
 				slog.Int("status", wrapped.statusCode),
 				slog.Duration("duration", duration),
-				slog.String("request_id", r.Header.Get("X-Request-ID")),
+				slog.String("request_id", applog.RequestID(r.Context())),
 			)
 		})
 	}
@@ -95,16 +113,50 @@ func (w *statusResponseWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
+// Unwrap exposes the underlying writer so http.ResponseController can
+// reach Flush for streaming responses.
+func (w *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // CORSMiddleware adds CORS headers to responses.
-// For production, you should configure allowed origins rather than using "*".
-func CORSMiddleware() Middleware {
+//
+// With no allowed origins every origin gets "*". Otherwise the request's
+// Origin is echoed back only if it is in the list, and "Vary: Origin" is
+// always set so shared caches keep per-origin responses apart.
+// allowCredentials adds Access-Control-Allow-Credentials to echoed origins;
+// browsers never send credentials to "*", so it has no effect there.
+// maxAge controls Access-Control-Max-Age; 0 leaves it to the browser.
+// Preflight OPTIONS requests are answered with 204 and never reach next.
+func CORSMiddleware(allowedOrigins []string, allowCredentials bool, maxAge int) Middleware {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, o := range allowedOrigins {
+		allowed[o] = true
+	}
+	allowAny := len(allowed) == 0 || allowed["*"]
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// TODO: Make this configurable for production
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-API-Key, X-Timezone")
-			w.Header().Set("Access-Control-Max-Age", "3600")
+			if allowAny {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				// The response depends on Origin whether or not it matches
+				w.Header().Add("Vary", "Origin")
+				if origin := r.Header.Get("Origin"); allowed[origin] {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+					if allowCredentials {
+						w.Header().Set("Access-Control-Allow-Credentials", "true")
+					}
+				}
+			}
+			w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, POST, PATCH, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, X-API-Key, X-Timezone, X-Request-ID")
+			// Let browser clients read the request ID, cache validators,
+			// and the retry hint on 429 and 503 responses
+			w.Header().Set("Access-Control-Expose-Headers", "X-Request-ID, ETag, Last-Modified, Retry-After")
+			if maxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(maxAge))
+			}
 
 			// Handle preflight requests
 			if r.Method == http.MethodOptions {
@@ -117,6 +169,295 @@ func CORSMiddleware() Middleware {
 	}
 }
 
+// RequireJSONMiddleware rejects requests with a body whose Content-Type is
+// not application/json, returning 415 Unsupported Media Type.
+// Media type parameters such as charset are ignored. Apply it to write routes
+// that decode a JSON body so clients get a clear error instead of a parse failure.
+func RequireJSONMiddleware() Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Nothing to decode, so the content type doesn't matter
+			if r.ContentLength == 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || mediaType != "application/json" {
+				WriteError(w, http.StatusUnsupportedMediaType,
+					"Content-Type must be application/json", "UNSUPPORTED_MEDIA_TYPE")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// TrailingSlashMiddleware normalizes request paths ending in "/" according
+// to mode (see config.TrailingSlash*). The Go mux treats "/x/" and "/x" as
+// different routes, so without this "/api/v1/readings/today/" is a 404.
+//   - redirect: 308 Permanent Redirect to the path without the slash
+//   - rewrite:  serve the request as if the slash were absent
+//   - strict:   leave the path alone
+//
+// The root path "/" is never modified.
+func TrailingSlashMiddleware(mode string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path := r.URL.Path
+			if path == "/" || !strings.HasSuffix(path, "/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			trimmed := strings.TrimRight(path, "/")
+			if trimmed == "" {
+				trimmed = "/"
+			}
+
+			switch mode {
+			case config.TrailingSlashRedirect:
+				target := *r.URL
+				target.Path = trimmed
+				target.RawPath = ""
+				http.Redirect(w, r, target.RequestURI(), http.StatusPermanentRedirect)
+				return
+			case config.TrailingSlashRewrite:
+				r.URL.Path = trimmed
+				r.URL.RawPath = ""
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ConcurrencyLimitMiddleware caps how many requests it wraps may run at once.
+// Requests over the limit are shed immediately with 503 and Retry-After
+// rather than queued, so expensive routes can't tie up the single SQLite
+// connection and starve cheap lookups. A limit of 0 or less disables it.
+//
+// Create one instance and share it across all the routes it should count.
+func ConcurrencyLimitMiddleware(limit int) Middleware {
+	if limit <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	slots := make(chan struct{}, limit)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+				next.ServeHTTP(w, r)
+			default:
+				w.Header().Set("Retry-After", "1")
+				WriteError(w, http.StatusServiceUnavailable,
+					"Server is busy, please retry shortly", "SERVER_BUSY")
+			}
+		})
+	}
+}
+
+// rateLimitSweepInterval is how often idle client buckets are dropped.
+const rateLimitSweepInterval = time.Minute
+
+// RateLimiter is a per-key token bucket limiter. Each key holds up to burst
+// tokens, refilled at rate tokens per second; a request spends one token.
+// Safe for concurrent use.
+type RateLimiter struct {
+	mu        sync.Mutex
+	rate      float64 // Tokens added per second
+	burst     float64 // Bucket capacity
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a limiter allowing perMinute requests per minute
+// per key, with bursts of up to burst requests (at least 1).
+func NewRateLimiter(perMinute, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:    float64(perMinute) / 60,
+		burst:   float64(max(burst, 1)),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// Allow spends a token for key. If none is available it returns false and
+// how long until one will be.
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	} else {
+		b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+// sweep drops buckets that have refilled completely, since a fresh bucket
+// is equivalent. Callers must hold l.mu.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// RateLimitMiddleware limits each client IP to perMinute requests per
+// minute, allowing bursts of up to burst. Requests over the limit get 429
+// with Retry-After. A rate of 0 or less disables it.
+//
+// With trustProxy set the client IP is taken from X-Forwarded-For, which
+// is only safe when a reverse proxy always sets that header.
+//
+// Create one instance and share it across all the routes it should count.
+func RateLimitMiddleware(perMinute, burst int, trustProxy bool) Middleware {
+	if perMinute <= 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	limiter := NewRateLimiter(perMinute, burst)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			allowed, wait := limiter.Allow(clientIP(r, trustProxy))
+			if !allowed {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				WriteError(w, http.StatusTooManyRequests,
+					"Too many requests, please slow down", "RATE_LIMITED")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// clientIP returns the IP address a request came from. With trustProxy it
+// uses the last X-Forwarded-For entry, the one appended by our own proxy;
+// earlier entries are client-supplied and can be forged.
+func clientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			if ip := strings.TrimSpace(hops[len(hops)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// defaultMaintenanceRetry is the Retry-After sent during maintenance with no end time.
+const defaultMaintenanceRetry = 5 * time.Minute
+
+// Maintenance holds the in-memory maintenance mode flag toggled by admins.
+// It is not persisted: a restart always comes back out of maintenance.
+// Safe for concurrent use.
+type Maintenance struct {
+	mu      sync.RWMutex
+	enabled bool
+	message string
+	until   time.Time // Zero means no auto-expiry
+}
+
+// Enable turns on maintenance mode. A positive duration makes it expire
+// automatically; zero leaves it on until Disable is called.
+func (m *Maintenance) Enable(message string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.enabled = true
+	m.message = message
+	m.until = time.Time{}
+	if duration > 0 {
+		m.until = time.Now().Add(duration)
+	}
+}
+
+// Disable turns off maintenance mode.
+func (m *Maintenance) Disable() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.enabled = false
+	m.message = ""
+	m.until = time.Time{}
+}
+
+// Status reports whether maintenance mode is active, its message, and when
+// it expires (zero if it doesn't). Expired maintenance reports inactive.
+func (m *Maintenance) Status() (active bool, message string, until time.Time) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if !m.enabled || (!m.until.IsZero() && time.Now().After(m.until)) {
+		return false, "", time.Time{}
+	}
+	return true, m.message, m.until
+}
+
+// MaintenanceMiddleware returns 503 Service Unavailable with a Retry-After
+// header while maintenance mode is active. Apply it to the readings routes
+// only, so /health and the admin toggle keep working.
+func MaintenanceMiddleware(m *Maintenance) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			active, message, until := m.Status()
+			if !active {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			retry := defaultMaintenanceRetry
+			if !until.IsZero() {
+				retry = time.Until(until)
+			}
+			w.Header().Set("Retry-After", strconv.Itoa(int(retry.Seconds()+0.5)))
+
+			if message == "" {
+				message = "Service is undergoing maintenance"
+			}
+			WriteError(w, http.StatusServiceUnavailable, message, "MAINTENANCE")
+		})
+	}
+}
+
 // RecoveryMiddleware recovers from panics and returns a 500 error.
 // It logs the panic with stack trace information.
 func RecoveryMiddleware(logger *slog.Logger) Middleware {
@@ -128,7 +469,7 @@ func RecoveryMiddleware(logger *slog.Logger) Middleware {
 						slog.Any("error", err),
 						slog.String("path", r.URL.Path),
 						slog.String("method", r.Method),
-						slog.String("request_id", r.Header.Get("X-Request-ID")),
+						slog.String("request_id", applog.RequestID(r.Context())),
 					)
 					WriteInternalError(w, "Internal server error")
 				}
@@ -138,9 +479,15 @@ func RecoveryMiddleware(logger *slog.Logger) Middleware {
 	}
 }
 
-// AuthMiddleware validates API key for authenticated endpoints.
-// The API key should be passed in the X-API-Key header.
-// AuthMiddleware validates API key and loads user into context.
+// contextKey is the type for request context keys set by this package,
+// so they can't collide with keys from other packages.
+type contextKey string
+
+// userContextKey holds the authenticated *database.User.
+const userContextKey contextKey = "user"
+
+// AuthMiddleware validates the API key in the X-API-Key header against the
+// hashed keys in the database and loads the key's user into the context.
 func AuthMiddleware(db *database.DB, logger *slog.Logger) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -155,6 +502,10 @@ func AuthMiddleware(db *database.DB, logger *slog.Logger) Middleware {
 			// Validate key and get user
 			user, err := db.ValidateAPIKey(ctx, apiKey)
 			if err != nil {
+				if errors.Is(err, database.ErrKeyExpired) {
+					WriteError(w, http.StatusUnauthorized, "API key has expired", "KEY_EXPIRED")
+					return
+				}
 				if database.IsNotFound(err) {
 					logger.Warn("invalid API key attempt",
 						slog.String("remote_addr", r.RemoteAddr),
@@ -170,8 +521,12 @@ func AuthMiddleware(db *database.DB, logger *slog.Logger) Middleware {
 				return
 			}
 
+			// Record usage without holding up the request; shutdown
+			// waits for it before closing the database
+			db.Go(func() { touchAPIKey(db, logger, apiKey) })
+
 			// Store user in context
-			ctx = context.WithValue(ctx, "user", user)
+			ctx = context.WithValue(ctx, userContextKey, user)
 			r = r.WithContext(ctx)
 
 			next.ServeHTTP(w, r)
@@ -179,13 +534,30 @@ func AuthMiddleware(db *database.DB, logger *slog.Logger) Middleware {
 	}
 }
 
-// AdminOnlyMiddleware ensures request is from admin user.
+// touchAPIKeyTimeout bounds the background last-used update.
+const touchAPIKeyTimeout = 5 * time.Second
+
+// touchAPIKey records that apiKey was used. It runs after the request has
+// moved on, so it uses its own context rather than the request's.
+func touchAPIKey(db *database.DB, logger *slog.Logger, apiKey string) {
+	ctx, cancel := context.WithTimeout(context.Background(), touchAPIKeyTimeout)
+	defer cancel()
+
+	if err := db.TouchAPIKey(ctx, apiKey); err != nil {
+		logger.Warn("failed to record api key use",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// AdminOnlyMiddleware ensures the request carries the configured admin key.
+// If no admin key is configured, admin endpoints are closed to everyone.
 func AdminOnlyMiddleware(cfg *config.Config, logger *slog.Logger) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			apiKey := r.Header.Get("X-API-Key")
 
-			if apiKey != cfg.AdminAPIKey {
+			if cfg.AdminAPIKey == "" || subtle.ConstantTimeCompare([]byte(apiKey), []byte(cfg.AdminAPIKey)) != 1 {
 				logger.Warn("admin endpoint access attempt by non-admin",
 					slog.String("remote_addr", r.RemoteAddr),
 					slog.String("path", r.URL.Path),
@@ -201,7 +573,7 @@ func AdminOnlyMiddleware(cfg *config.Config, logger *slog.Logger) Middleware {
 
 // GetUser extracts the authenticated user from request context.
 func GetUser(r *http.Request) *database.User {
-	if user, ok := r.Context().Value("user").(*database.User); ok {
+	if user, ok := r.Context().Value(userContextKey).(*database.User); ok {
 		return user
 	}
 	return nil
@@ -242,19 +614,39 @@ func GetRequestTimezone(r *http.Request) (*time.Location, bool) {
 // then converted to UTC for consistent storage/lookup.
 func GetTodayForRequest(r *http.Request) time.Time {
 	loc, _ := GetRequestTimezone(r)
-	now := time.Now().In(loc)
-	// Return midnight in the user's timezone, converted to UTC
-	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return todayIn(loc)
+}
+
+// todayIn returns today's date in loc as midnight UTC.
+func todayIn(loc *time.Location) time.Time {
+	return calendar.NormalizeToMidnight(time.Now().In(loc))
 }
 
 // generateRequestID generates a unique request ID.
-// Format: timestamp-randomhex (e.g., "20060102150405-a1b2c3d4")
+// Format: timestamp-randomhex (e.g., "20060102150405-a1b2c3d4e5f60718")
+// The 64 random bits make collisions within the same second negligible.
 func generateRequestID() string {
 	timestamp := time.Now().Format("20060102150405")
-	randomPart := randomHex(4) // 4 bytes = 8 hex chars
+	randomPart := randomHex(8) // 8 bytes = 16 hex chars
 	return fmt.Sprintf("%s-%s", timestamp, randomPart)
 }
 
+// validRequestID reports whether a client-supplied request ID is safe to
+// echo and log: non-empty, bounded, and limited to [A-Za-z0-9._-].
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '.', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 // randomHex generates a cryptographically random hex string of n bytes.
 func randomHex(n int) string {
 	bytes := make([]byte, n)
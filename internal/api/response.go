@@ -1,15 +1,23 @@
 package api
 
 import (
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zapponejosh/lectionary-api/internal/calendar"
 )
 
 // Response represents a standard API response.
 type Response struct {
 	Success bool        `json:"success"`
 	Data    interface{} `json:"data,omitempty"`
+	Meta    interface{} `json:"meta,omitempty"` // Extra details about Data, e.g. partial results
 	Error   *ErrorInfo  `json:"error,omitempty"`
 }
 
@@ -55,6 +63,115 @@ func (rw *ResponseWriter) WriteSuccess(w http.ResponseWriter, data interface{})
 	})
 }
 
+// WriteSuccessWithMeta writes a successful JSON response with details
+// about the data alongside it, leaving the shape of data unchanged.
+func (rw *ResponseWriter) WriteSuccessWithMeta(w http.ResponseWriter, data, meta interface{}) {
+	rw.WriteJSON(w, http.StatusOK, Response{
+		Success: true,
+		Data:    data,
+		Meta:    meta,
+	})
+}
+
+// NotModified sets the ETag and Last-Modified validators on the response
+// and reports whether the request's conditional headers show the client
+// already has this version. If so it writes 304 Not Modified (no body) and
+// returns true. If-None-Match takes precedence over If-Modified-Since.
+func (rw *ResponseWriter) NotModified(w http.ResponseWriter, r *http.Request, etag string, lastModified time.Time) bool {
+	w.Header().Set("ETag", etag)
+	if !lastModified.IsZero() {
+		w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	match := false
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				match = true
+				break
+			}
+		}
+	} else if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		if since, err := http.ParseTime(ims); err == nil {
+			match = !lastModified.Truncate(time.Second).After(since)
+		}
+	}
+
+	if match {
+		w.WriteHeader(http.StatusNotModified)
+	}
+	return match
+}
+
+// WriteText writes a plain-text response with the given status code.
+func (rw *ResponseWriter) WriteText(w http.ResponseWriter, status int, text string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(status)
+
+	if _, err := io.WriteString(w, text); err != nil && rw.logger != nil {
+		rw.logger.Error("failed to write text response",
+			slog.Any("error", err),
+			slog.Int("status", status),
+		)
+	}
+}
+
+// WriteCSV writes rows as a CSV attachment with the given filename.
+func (rw *ResponseWriter) WriteCSV(w http.ResponseWriter, filename string, rows [][]string) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	if err := cw.WriteAll(rows); err != nil && rw.logger != nil {
+		rw.logger.Error("failed to write CSV response",
+			slog.Any("error", err),
+		)
+	}
+}
+
+// formatReadingText renders a day's readings as a human-readable block:
+//
+//	2025-04-20
+//	Feast: Easter Day
+//	Morning Psalms: 93; 98
+//	First Reading: Exodus 12:1-14
+//	...
+//
+// The second line is the principal feast if there is one, otherwise the
+// season. Readings excluded by ?type= are left out.
+func formatReadingText(reading readingResponse) string {
+	var b strings.Builder
+	b.WriteString(reading.Date + "\n")
+
+	if date, err := time.Parse("2006-01-02", reading.Date); err == nil {
+		if feast, ok := calendar.FeastOn(date); ok {
+			b.WriteString("Feast: " + feast.Name + "\n")
+		} else {
+			b.WriteString("Season: " + calendar.SeasonOf(date).Name + "\n")
+		}
+	}
+
+	b.WriteString("Morning Psalms: " + strings.Join(reading.MorningPsalms, "; ") + "\n")
+	for _, line := range []struct {
+		label     string
+		reference *string
+	}{
+		{"First Reading", reading.FirstReading},
+		{"Second Reading", reading.SecondReading},
+		{"Gospel", reading.GospelReading},
+		{"Canticle", reading.Canticle},
+	} {
+		if line.reference != nil {
+			b.WriteString(line.label + ": " + *line.reference + "\n")
+		}
+	}
+	b.WriteString("Evening Psalms: " + strings.Join(reading.EveningPsalms, "; ") + "\n")
+
+	return b.String()
+}
+
 // WriteError writes an error JSON response.
 func (rw *ResponseWriter) WriteError(w http.ResponseWriter, status int, message string, code string) {
 	rw.WriteJSON(w, status, Response{
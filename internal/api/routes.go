@@ -16,16 +16,41 @@ import (
 func SetupRoutes(handlers *Handlers, cfg *config.Config, logger *slog.Logger) http.Handler {
 	mux := http.NewServeMux()
 
-	baseMiddleware := ChainMiddleware(
+	middlewares := []Middleware{
+		RequestIDMiddleware(), // First, so recovery and logging can tag the request ID
 		RecoveryMiddleware(logger),
-		RequestIDMiddleware(),
 		LoggingMiddleware(logger),
-		CORSMiddleware(),
-	)
+		CORSMiddleware(cfg.CORSAllowedOrigins, cfg.CORSAllowCredentials, cfg.CORSMaxAge),
+		TrailingSlashMiddleware(cfg.TrailingSlash),
+	}
+
+	// Metrics go last so they see the route pattern the mux matched
+	var metrics *Metrics
+	if cfg.MetricsEnabled {
+		metrics = NewMetrics()
+		middlewares = append(middlewares, MetricsMiddleware(metrics))
+	}
+
+	baseMiddleware := ChainMiddleware(middlewares...)
 
 	// Auth middleware for regular users
 	authWrap := AuthMiddleware(handlers.db, logger)
 
+	// Write routes must send JSON bodies
+	jsonOnly := RequireJSONMiddleware()
+
+	// Public API routes are rate limited per client IP
+	rateLimit := RateLimitMiddleware(cfg.RateLimitPerMinute, cfg.RateLimitBurst, cfg.TrustProxy)
+
+	// Readings are rate limited and return 503 while an admin has maintenance mode on
+	maintenance := MaintenanceMiddleware(handlers.maintenance)
+	readingsWrap := func(h http.Handler) http.Handler {
+		return rateLimit(maintenance(h))
+	}
+
+	// Range-style requests share a small pool of slots
+	heavy := ConcurrencyLimitMiddleware(cfg.MaxHeavyConcurrency)
+
 	// Admin-only middleware
 	adminWrap := func(h http.Handler) http.Handler {
 		return AdminOnlyMiddleware(cfg, logger)(h)
@@ -33,11 +58,41 @@ func SetupRoutes(handlers *Handlers, cfg *config.Config, logger *slog.Logger) ht
 
 	// ==========================================================================
 	// Public routes
+	//
+	// GET patterns also match HEAD, so monitors and caches can check a
+	// reading's status and ETag without the body; net/http discards it.
 	// ==========================================================================
+	mux.HandleFunc("GET /{$}", handlers.Index)
+	mux.HandleFunc("GET /favicon.ico", handlers.Favicon)
 	mux.HandleFunc("GET /health", handlers.HealthCheck)
-	mux.HandleFunc("GET /api/v1/readings/today", handlers.GetTodayReadings)
-	mux.HandleFunc("GET /api/v1/readings/date/{date}", handlers.GetDateReadings)
-	mux.HandleFunc("GET /api/v1/readings/range", handlers.GetRangeReadings)
+	mux.HandleFunc("GET /readyz", handlers.ReadinessCheck)
+	mux.HandleFunc("GET /openapi.json", handlers.OpenAPISpec)
+	mux.HandleFunc("GET /openapi.yaml", handlers.OpenAPISpec)
+	mux.HandleFunc("GET /docs", handlers.Docs)
+	if metrics != nil {
+		mux.Handle("GET /metrics", metrics)
+	}
+	mux.Handle("GET /api/v1/readings/today", readingsWrap(http.HandlerFunc(handlers.GetTodayReadings)))
+	mux.Handle("GET /api/v1/readings/next-sunday", readingsWrap(http.HandlerFunc(handlers.GetNextSundayReadings)))
+	mux.Handle("GET /api/v1/readings/date/{date}", readingsWrap(http.HandlerFunc(handlers.GetDateReadings)))
+	mux.Handle("GET /api/v1/readings/date/{date}/psalms", readingsWrap(http.HandlerFunc(handlers.GetDatePsalms)))
+	mux.Handle("GET /api/v1/readings/{id}", readingsWrap(http.HandlerFunc(handlers.GetReadingByID)))
+	mux.Handle("POST /api/v1/readings/dates", readingsWrap(jsonOnly(heavy(http.HandlerFunc(handlers.GetReadingsForDates)))))
+	mux.Handle("GET /api/v1/readings/range", readingsWrap(heavy(http.HandlerFunc(handlers.GetRangeReadings))))
+	mux.Handle("GET /api/v1/readings/month/{month}", readingsWrap(heavy(http.HandlerFunc(handlers.GetMonthReadings))))
+	mux.Handle("GET /api/v1/readings/week/{date}", readingsWrap(http.HandlerFunc(handlers.GetWeekReadings)))
+	mux.Handle("GET /api/v1/readings/season/{name}", readingsWrap(heavy(http.HandlerFunc(handlers.GetSeasonReadings))))
+	mux.Handle("GET /api/v1/calendar/{year}", rateLimit(http.HandlerFunc(handlers.GetKeyDates)))
+	mux.Handle("GET /api/v1/calendar.ics", readingsWrap(heavy(http.HandlerFunc(handlers.GetCalendarICS))))
+	mux.Handle("GET /api/v1/feed.atom", readingsWrap(http.HandlerFunc(handlers.GetFeedAtom)))
+	mux.Handle("GET /api/v1/psalms/today", readingsWrap(http.HandlerFunc(handlers.GetTodayPsalms)))
+	mux.Handle("GET /api/v1/book/{book}", readingsWrap(heavy(http.HandlerFunc(handlers.GetBookReadings))))
+	mux.Handle("GET /api/v1/where", readingsWrap(heavy(http.HandlerFunc(handlers.GetReferencePlacement))))
+	mux.Handle("GET /api/v1/search", readingsWrap(http.HandlerFunc(handlers.SearchReadings)))
+	mux.Handle("GET /api/v1/sundays/{year}", readingsWrap(heavy(http.HandlerFunc(handlers.GetSundayReadings))))
+	mux.Handle("GET /api/v1/eve/{feast}", readingsWrap(http.HandlerFunc(handlers.GetFeastEve)))
+	mux.Handle("GET /api/v1/special-days/{name}", rateLimit(http.HandlerFunc(handlers.GetSpecialDay)))
+	mux.Handle("GET /api/v1/countdown/{feast}", rateLimit(http.HandlerFunc(handlers.GetFeastCountdown)))
 
 	// ==========================================================================
 	// User routes (authenticated)
@@ -47,16 +102,27 @@ func SetupRoutes(handlers *Handlers, cfg *config.Config, logger *slog.Logger) ht
 	mux.Handle("DELETE /api/v1/me/keys/{keyID}", authWrap(http.HandlerFunc(handlers.RevokeMyAPIKey)))
 
 	mux.Handle("GET /api/v1/progress", authWrap(http.HandlerFunc(handlers.GetProgress)))
-	mux.Handle("POST /api/v1/progress", authWrap(http.HandlerFunc(handlers.CreateProgress)))
+	mux.Handle("POST /api/v1/progress", authWrap(jsonOnly(http.HandlerFunc(handlers.CreateProgress))))
+	mux.Handle("POST /api/v1/progress/day", authWrap(jsonOnly(http.HandlerFunc(handlers.MarkDayComplete))))
+	mux.Handle("DELETE /api/v1/progress", authWrap(http.HandlerFunc(handlers.ResetProgress)))
 	mux.Handle("DELETE /api/v1/progress/{id}", authWrap(http.HandlerFunc(handlers.DeleteProgress)))
+	mux.Handle("PATCH /api/v1/progress/{id}", authWrap(jsonOnly(http.HandlerFunc(handlers.UpdateProgressNotes))))
 	mux.Handle("GET /api/v1/progress/stats", authWrap(http.HandlerFunc(handlers.GetProgressStats)))
 
+	mux.Handle("POST /api/v1/plans", authWrap(jsonOnly(http.HandlerFunc(handlers.CreateReadingPlan))))
+	mux.Handle("GET /api/v1/plans/{id}", authWrap(http.HandlerFunc(handlers.GetReadingPlan)))
+
 	// ==========================================================================
 	// Admin routes (admin key only)
 	// ==========================================================================
 	mux.Handle("GET /api/v1/admin/users", adminWrap(http.HandlerFunc(handlers.ListUsers)))
-	mux.Handle("POST /api/v1/admin/users", adminWrap(http.HandlerFunc(handlers.CreateUser)))
-	mux.Handle("POST /api/v1/admin/users/{userID}/keys", adminWrap(http.HandlerFunc(handlers.CreateAPIKey)))
+	mux.Handle("POST /api/v1/admin/users", adminWrap(jsonOnly(http.HandlerFunc(handlers.CreateUser))))
+	mux.Handle("POST /api/v1/admin/users/{userID}/keys", adminWrap(jsonOnly(http.HandlerFunc(handlers.CreateAPIKey))))
+	mux.Handle("GET /api/v1/admin/snapshot.db.gz", adminWrap(heavy(http.HandlerFunc(handlers.GetSnapshot))))
+	mux.Handle("GET /api/v1/admin/completeness", adminWrap(http.HandlerFunc(handlers.GetCompleteness)))
+	mux.Handle("POST /api/v1/admin/maintenance", adminWrap(jsonOnly(http.HandlerFunc(handlers.SetMaintenance))))
+	mux.Handle("PATCH /api/v1/admin/readings/{id}", adminWrap(jsonOnly(http.HandlerFunc(handlers.UpdateReading))))
+	mux.Handle("POST /api/v1/admin/import/preflight", adminWrap(jsonOnly(heavy(http.HandlerFunc(handlers.ImportPreflight)))))
 
 	return baseMiddleware(mux)
 }
@@ -0,0 +1,20 @@
+package calendar
+
+import "time"
+
+// NormalizeToMidnight returns t's calendar date, in t's own location, as
+// midnight UTC. Every date the package computes is midnight UTC, so
+// inputs are normalized first to compare and subtract them safely
+// whatever their time of day or zone.
+func NormalizeToMidnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// DaysBetween returns the number of calendar days from start to end,
+// negative if end is earlier. Both are normalized to midnight first, so
+// the time of day, zone, and DST changes don't affect the count, unlike
+// dividing a Duration by 24 hours.
+func DaysBetween(start, end time.Time) int {
+	// Midnight UTC values are a whole number of days apart
+	return int(NormalizeToMidnight(end).Sub(NormalizeToMidnight(start)) / (24 * time.Hour))
+}
@@ -0,0 +1,132 @@
+// Package calendar provides liturgical calendar calculations.
+package calendar
+
+import (
+	"time"
+)
+
+// Liturgical calendar constants
+const (
+	// DaysFromEasterToAshWednesday is the number of days before Easter that Ash Wednesday falls.
+	// This is 46 days: 40 days of Lent + 6 Sundays (which aren't counted in Lent).
+	DaysFromEasterToAshWednesday = 46
+
+	// DaysFromEasterToAscension is the number of days after Easter for Ascension Thursday.
+	DaysFromEasterToAscension = 39
+
+	// DaysFromEasterToPentecost is the number of days after Easter for Pentecost Sunday.
+	// This is 7 weeks (49 days).
+	DaysFromEasterToPentecost = 49
+
+	// DaysFromEasterToPalmSunday is the number of days before Easter that Palm Sunday falls.
+	DaysFromEasterToPalmSunday = 7
+
+	// DaysFromEasterToTrinity is the number of days after Easter for Trinity Sunday,
+	// the Sunday after Pentecost.
+	DaysFromEasterToTrinity = 56
+)
+
+// CalculateEaster calculates the date of Easter Sunday for a given year
+// using the computus algorithm for the Gregorian calendar.
+//
+// The algorithm is based on the method described by J.M. Oudin (1940)
+// and is valid for all years in the Gregorian calendar (1583 onwards).
+//
+// Easter falls on the first Sunday after the first full moon occurring
+// on or after the spring equinox (March 21).
+func CalculateEaster(year int) time.Time {
+	// Computus algorithm for Gregorian calendar
+	// See: https://en.wikipedia.org/wiki/Computus#Anonymous_Gregorian_algorithm
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := ((h + l - 7*m + 114) % 31) + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// CalculateOrthodoxEaster calculates the date of Orthodox Easter (Pascha)
+// for a given year, expressed as a Gregorian calendar date.
+//
+// Pascha is computed with the Julian computus (Meeus' algorithm) and then
+// shifted by the Julian-Gregorian offset, which is 13 days from 1900 to
+// 2099. It falls on the same day as Western Easter or up to five weeks later.
+func CalculateOrthodoxEaster(year int) time.Time {
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	month := (d + e + 114) / 31
+	day := ((d + e + 114) % 31) + 1
+
+	// Pascha is always after the century's leap-day divergence (Feb 29),
+	// so the offset for the year itself applies.
+	offset := year/100 - year/400 - 2
+
+	julian := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return julian.AddDate(0, 0, offset)
+}
+
+// CalculateAdvent calculates the date of the first Sunday of Advent
+// for a given year.
+//
+// Advent Sunday is the fourth Sunday before Christmas Day, which means
+// it's the Sunday nearest to November 30 (St. Andrew's Day). This places
+// Advent Sunday between November 27 and December 3 inclusive.
+func CalculateAdvent(year int) time.Time {
+	// The Sunday nearest November 30 is the Sunday on or before December 3
+	dec3 := time.Date(year, time.December, 3, 0, 0, 0, 0, time.UTC)
+	return dec3.AddDate(0, 0, -int(dec3.Weekday()))
+}
+
+// CalculateAshWednesday calculates Ash Wednesday for a given year.
+// Ash Wednesday marks the beginning of Lent, occurring 46 days before Easter.
+func CalculateAshWednesday(year int) time.Time {
+	easter := CalculateEaster(year)
+	return easter.AddDate(0, 0, -DaysFromEasterToAshWednesday)
+}
+
+// CalculateAscension calculates Ascension Day for a given year.
+// Ascension is 39 days after Easter (always on a Thursday).
+func CalculateAscension(year int) time.Time {
+	easter := CalculateEaster(year)
+	return easter.AddDate(0, 0, DaysFromEasterToAscension)
+}
+
+// CalculatePentecost calculates Pentecost Sunday for a given year.
+// Pentecost is 49 days after Easter (7 weeks).
+func CalculatePentecost(year int) time.Time {
+	easter := CalculateEaster(year)
+	return easter.AddDate(0, 0, DaysFromEasterToPentecost)
+}
+
+// CalculatePalmSunday calculates Palm Sunday for a given year.
+// Palm Sunday is the Sunday before Easter, beginning Holy Week.
+func CalculatePalmSunday(year int) time.Time {
+	easter := CalculateEaster(year)
+	return easter.AddDate(0, 0, -DaysFromEasterToPalmSunday)
+}
+
+// CalculateTrinitySunday calculates Trinity Sunday for a given year.
+// Trinity Sunday is the Sunday after Pentecost, 56 days after Easter.
+func CalculateTrinitySunday(year int) time.Time {
+	easter := CalculateEaster(year)
+	return easter.AddDate(0, 0, DaysFromEasterToTrinity)
+}
+
+// CalculateChristTheKing calculates Christ the King for a given year.
+// It is the last Sunday of the liturgical year, the Sunday before Advent.
+func CalculateChristTheKing(year int) time.Time {
+	return CalculateAdvent(year).AddDate(0, 0, -7)
+}
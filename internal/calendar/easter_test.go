@@ -0,0 +1,549 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculateEaster(t *testing.T) {
+	tests := map[int]string{
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2026: "2026-04-05",
+		2038: "2038-04-25", // Latest possible date
+		2285: "2285-03-22", // Earliest possible date
+	}
+
+	for year, want := range tests {
+		if got := CalculateEaster(year).Format("2006-01-02"); got != want {
+			t.Errorf("CalculateEaster(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+func TestCalculateOrthodoxEaster(t *testing.T) {
+	tests := map[int]string{
+		2024: "2024-05-05",
+		2025: "2025-04-20", // Same day as Western Easter
+		2026: "2026-04-12",
+		2027: "2027-05-02",
+		2028: "2028-04-16",
+	}
+
+	for year, want := range tests {
+		if got := CalculateOrthodoxEaster(year).Format("2006-01-02"); got != want {
+			t.Errorf("CalculateOrthodoxEaster(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+func TestFeastIn_Orthodox(t *testing.T) {
+	pentecost, _ := LookupFeast("pentecost")
+	if got := pentecost.In(Orthodox).Date(2024).Format("2006-01-02"); got != "2024-06-23" {
+		t.Errorf("Orthodox Pentecost 2024 = %s, want 2024-06-23", got)
+	}
+	if got := pentecost.In(Western).Date(2024).Format("2006-01-02"); got != "2024-05-19" {
+		t.Errorf("Western Pentecost 2024 = %s, want 2024-05-19", got)
+	}
+
+	// Fixed feasts don't move
+	christmas, _ := LookupFeast("christmas")
+	if got := christmas.In(Orthodox).Date(2024).Format("2006-01-02"); got != "2024-12-25" {
+		t.Errorf("Orthodox-mode Christmas 2024 = %s, want 2024-12-25", got)
+	}
+
+	// Related feasts follow the same computus
+	easter, _ := LookupFeast("easter")
+	for _, rel := range easter.In(Orthodox).Related(2024) {
+		if rel.Key == "palm-sunday" && rel.On.Format("2006-01-02") != "2024-04-28" {
+			t.Errorf("Orthodox related Palm Sunday 2024 = %s, want 2024-04-28", rel.On.Format("2006-01-02"))
+		}
+	}
+
+	if _, ok := ParseComputus("Julian"); ok {
+		t.Error("ParseComputus(Julian) should fail")
+	}
+}
+
+func TestCalculateAdvent(t *testing.T) {
+	tests := map[int]string{
+		2022: "2022-11-27", // Christmas on Sunday: earliest possible date
+		2023: "2023-12-03", // Christmas on Monday: latest possible date
+		2024: "2024-12-01",
+		2025: "2025-11-30",
+		2026: "2026-11-29",
+		2027: "2027-11-28",
+		2028: "2028-12-03",
+		2029: "2029-12-02",
+		2030: "2030-12-01",
+	}
+
+	for year, want := range tests {
+		got := CalculateAdvent(year)
+		if got.Format("2006-01-02") != want {
+			t.Errorf("CalculateAdvent(%d) = %s, want %s", year, got.Format("2006-01-02"), want)
+		}
+		if got.Weekday() != time.Sunday {
+			t.Errorf("CalculateAdvent(%d) falls on %s", year, got.Weekday())
+		}
+		christmas := time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC)
+		if days := christmas.Sub(got).Hours() / 24; days < 22 || days > 28 {
+			t.Errorf("CalculateAdvent(%d) is %.0f days before Christmas, want 22-28", year, days)
+		}
+	}
+}
+
+func TestFeastEve(t *testing.T) {
+	tests := []struct {
+		key      string
+		year     int
+		wantEve  string
+		hasVigil bool
+	}{
+		{"christmas", 2025, "2025-12-24", true},
+		{"easter", 2025, "2025-04-19", true},
+		{"pentecost", 2025, "2025-06-07", true},
+		{"epiphany", 2026, "2026-01-05", true},
+		{"ascension", 2025, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			feast, ok := LookupFeast(tt.key)
+			if !ok {
+				t.Fatalf("LookupFeast(%q) not found", tt.key)
+			}
+
+			eve, ok := feast.Eve(tt.year)
+			if ok != tt.hasVigil {
+				t.Fatalf("Eve() ok = %v, want %v", ok, tt.hasVigil)
+			}
+			if ok && eve.Format("2006-01-02") != tt.wantEve {
+				t.Errorf("Eve(%d) = %s, want %s", tt.year, eve.Format("2006-01-02"), tt.wantEve)
+			}
+		})
+	}
+}
+
+func TestLookupFeast_CaseInsensitive(t *testing.T) {
+	feast, ok := LookupFeast(" Christmas ")
+	if !ok {
+		t.Fatal("LookupFeast should ignore case and surrounding space")
+	}
+	if got := feast.Date(2025); !got.Equal(time.Date(2025, time.December, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Christmas date = %v", got)
+	}
+
+	if feast, ok := LookupFeast("Ash Wednesday"); !ok || feast.Key != "ash-wednesday" {
+		t.Error("LookupFeast should match display names and spaced keys")
+	}
+	if feast, ok := LookupFeast("christmas day"); !ok || feast.Key != "christmas" {
+		t.Error("LookupFeast should match display names")
+	}
+
+	if _, ok := LookupFeast("lammas"); ok {
+		t.Error("LookupFeast should not find unknown feasts")
+	}
+}
+
+func TestFeastNextOccurrence(t *testing.T) {
+	christmas, _ := LookupFeast("christmas")
+	easter, _ := LookupFeast("easter")
+
+	tests := []struct {
+		name     string
+		feast    Feast
+		from     time.Time
+		want     string
+		wantDays int
+	}{
+		{"christmas ahead", christmas, time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC), "2025-12-25", 24},
+		{"christmas today", christmas, time.Date(2025, time.December, 25, 0, 0, 0, 0, time.UTC), "2025-12-25", 0},
+		{"christmas passed", christmas, time.Date(2025, time.December, 26, 0, 0, 0, 0, time.UTC), "2026-12-25", 364},
+		{"easter passed", easter, time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC), "2026-04-05", 339},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := tt.feast.NextOccurrence(tt.from)
+			if got := next.Format("2006-01-02"); got != tt.want {
+				t.Errorf("NextOccurrence() = %s, want %s", got, tt.want)
+			}
+			if days := int(next.Sub(tt.from).Hours() / 24); days != tt.wantDays {
+				t.Errorf("days until = %d, want %d", days, tt.wantDays)
+			}
+		})
+	}
+}
+
+func TestSundays(t *testing.T) {
+	tests := []struct {
+		year int
+		want int
+	}{
+		{2023, 53}, // Starts on a Sunday
+		{2025, 52},
+		{2028, 53}, // Leap year starting on a Saturday
+	}
+
+	for _, tt := range tests {
+		start := time.Date(tt.year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end := time.Date(tt.year, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+		sundays := Sundays(start, end)
+		if len(sundays) != tt.want {
+			t.Errorf("Sundays(%d) returned %d, want %d", tt.year, len(sundays), tt.want)
+		}
+		for _, d := range sundays {
+			if d.Weekday() != time.Sunday {
+				t.Errorf("Sundays(%d) included %s, a %s", tt.year, d.Format("2006-01-02"), d.Weekday())
+			}
+		}
+	}
+}
+
+func TestLiturgicalYearBounds(t *testing.T) {
+	start, end := LiturgicalYearBounds(2024)
+
+	if got := start.Format("2006-01-02"); got != "2024-12-01" {
+		t.Errorf("start = %s, want 2024-12-01", got)
+	}
+	if got := end.Format("2006-01-02"); got != "2025-11-29" {
+		t.Errorf("end = %s, want 2025-11-29", got)
+	}
+
+	// Advent 2023 falls as late as possible, so the 2022 year runs into December
+	_, end = LiturgicalYearBounds(2022)
+	if got := end.Format("2006-01-02"); got != "2023-12-02" {
+		t.Errorf("2022 end = %s, want 2023-12-02", got)
+	}
+}
+
+func TestFeastRelated(t *testing.T) {
+	easter, _ := LookupFeast("easter")
+
+	got := make(map[string]string)
+	for _, rel := range easter.Related(2025) {
+		got[rel.Key] = rel.On.Format("2006-01-02")
+	}
+
+	want := map[string]string{
+		"ash-wednesday": "2025-03-05",
+		"palm-sunday":   "2025-04-13",
+		"ascension":     "2025-05-29",
+		"pentecost":     "2025-06-08",
+	}
+	for key, date := range want {
+		if got[key] != date {
+			t.Errorf("Easter 2025 related %s = %q, want %q", key, got[key], date)
+		}
+	}
+
+	// The Christmas cycle crosses the new year
+	christmas, _ := LookupFeast("christmas")
+	for _, rel := range christmas.Related(2025) {
+		if rel.Key == "epiphany" && rel.On.Format("2006-01-02") != "2026-01-06" {
+			t.Errorf("Christmas 2025 related epiphany = %s, want 2026-01-06", rel.On.Format("2006-01-02"))
+		}
+	}
+
+	// Every related key must be a known feast
+	for key, rels := range relatedFeasts {
+		for _, rel := range rels {
+			if _, ok := LookupFeast(rel.key); !ok {
+				t.Errorf("relatedFeasts[%q] references unknown feast %q", key, rel.key)
+			}
+		}
+	}
+}
+
+func TestSeasonSpans(t *testing.T) {
+	format := func(spans []Span) []string {
+		var out []string
+		for _, s := range spans {
+			out = append(out, s.Start.Format("2006-01-02")+".."+s.End.Format("2006-01-02"))
+		}
+		return out
+	}
+
+	// Liturgical year beginning Advent 2024
+	tests := map[string][]string{
+		"advent":        {"2024-12-01..2024-12-24"},
+		"christmas":     {"2024-12-25..2025-01-05"},
+		"epiphany":      {"2025-01-06..2025-01-11"},
+		"lent":          {"2025-03-05..2025-04-19"},
+		"easter":        {"2025-04-20..2025-06-08"},
+		"ordinary-time": {"2025-01-12..2025-03-04", "2025-06-09..2025-11-29"},
+	}
+
+	for key, want := range tests {
+		season, ok := LookupSeason(key)
+		if !ok {
+			t.Fatalf("LookupSeason(%q) not found", key)
+		}
+		if got := format(season.Spans(2024)); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%s spans = %v, want %v", key, got, want)
+		}
+	}
+
+	if _, ok := LookupSeason("Ordinary Time"); !ok {
+		t.Error(`LookupSeason("Ordinary Time") should match ordinary-time`)
+	}
+}
+
+func TestLiturgicalYearOf(t *testing.T) {
+	tests := map[string]int{
+		"2024-11-30": 2023, // Saturday before Advent
+		"2024-12-01": 2024, // First Sunday of Advent
+		"2025-06-08": 2024,
+	}
+
+	for date, want := range tests {
+		d, _ := time.Parse("2006-01-02", date)
+		if got := LiturgicalYearOf(d); got != want {
+			t.Errorf("LiturgicalYearOf(%s) = %d, want %d", date, got, want)
+		}
+	}
+}
+
+func TestSeasonOf(t *testing.T) {
+	tests := map[string]string{
+		"2024-12-24": "advent",
+		"2024-12-25": "christmas",
+		"2025-01-06": "epiphany",
+		"2025-01-12": "ordinary-time", // Baptism of the Lord
+		"2025-03-05": "lent",          // Ash Wednesday
+		"2025-04-19": "lent",          // Holy Saturday
+		"2025-04-20": "easter",
+		"2025-06-08": "easter", // Pentecost
+		"2025-06-09": "ordinary-time",
+		"2025-11-30": "advent",
+	}
+
+	for date, want := range tests {
+		d, _ := time.Parse("2006-01-02", date)
+		if got := SeasonOf(d).Key; got != want {
+			t.Errorf("SeasonOf(%s) = %q, want %q", date, got, want)
+		}
+	}
+}
+
+func TestFeastOn(t *testing.T) {
+	easter := time.Date(2025, time.April, 20, 0, 0, 0, 0, time.UTC)
+	if f, ok := FeastOn(easter); !ok || f.Key != "easter" {
+		t.Errorf("FeastOn(2025-04-20) = %q, %v, want easter", f.Key, ok)
+	}
+	if _, ok := FeastOn(easter.AddDate(0, 0, 1)); ok {
+		t.Error("FeastOn(2025-04-21) should find no feast")
+	}
+}
+
+func TestCalculateTrinityAndChristTheKing(t *testing.T) {
+	if got, want := CalculateTrinitySunday(2025), time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("CalculateTrinitySunday(2025) = %s, want %s", got.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+	if got, want := CalculateChristTheKing(2025), time.Date(2025, time.November, 23, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("CalculateChristTheKing(2025) = %s, want %s", got.Format("2006-01-02"), want.Format("2006-01-02"))
+	}
+}
+
+func TestFeastInLiturgicalYear(t *testing.T) {
+	// The liturgical year 2024-2025 begins with Advent on 2024-12-01
+	tests := map[string]string{
+		"advent":        "2024-12-01",
+		"christmas":     "2024-12-25",
+		"epiphany":      "2025-01-06",
+		"ash-wednesday": "2025-03-05",
+		"pentecost":     "2025-06-08",
+	}
+
+	for key, want := range tests {
+		feast, _ := LookupFeast(key)
+		if got := feast.InLiturgicalYear(2024).Format("2006-01-02"); got != want {
+			t.Errorf("%s.InLiturgicalYear(2024) = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func TestKeyDatesFor(t *testing.T) {
+	for _, year := range []int{1583, 2024, 2025, 2038, 4099, 5000} {
+		for i := 0; i < 2; i++ { // Computed, then cached
+			kd := KeyDatesFor(year)
+			checks := []struct {
+				name      string
+				got, want time.Time
+			}{
+				{"BaptismOfTheLord", kd.BaptismOfTheLord, CalculateBaptismOfTheLord(year)},
+				{"AshWednesday", kd.AshWednesday, CalculateAshWednesday(year)},
+				{"PalmSunday", kd.PalmSunday, CalculatePalmSunday(year)},
+				{"Easter", kd.Easter, CalculateEaster(year)},
+				{"Ascension", kd.Ascension, CalculateAscension(year)},
+				{"Pentecost", kd.Pentecost, CalculatePentecost(year)},
+				{"TrinitySunday", kd.TrinitySunday, CalculateTrinitySunday(year)},
+				{"ChristTheKing", kd.ChristTheKing, CalculateChristTheKing(year)},
+				{"Advent", kd.Advent, CalculateAdvent(year)},
+			}
+			for _, c := range checks {
+				if !c.got.Equal(c.want) {
+					t.Errorf("KeyDatesFor(%d).%s = %s, want %s", year, c.name, c.got.Format("2006-01-02"), c.want.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+
+	if _, ok := keyDatesCache.Load(5000); ok {
+		t.Error("years past MaxYear should not be cached")
+	}
+}
+
+// BenchmarkSeasonOf resolves every day of a year, as the calendar and CSV
+// exports do; compare with BenchmarkSeasonOf_Uncached.
+func BenchmarkSeasonOf(b *testing.B) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for d := 0; d < 365; d++ {
+			SeasonOf(start.AddDate(0, 0, d))
+		}
+	}
+}
+
+func BenchmarkSeasonOf_Uncached(b *testing.B) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for d := 0; d < 365; d++ {
+			keyDatesCache.Clear()
+			SeasonOf(start.AddDate(0, 0, d))
+		}
+	}
+}
+
+func TestEmberDays(t *testing.T) {
+	var got []string
+	for _, d := range EmberDays(2025) {
+		got = append(got, d.Format("2006-01-02"))
+	}
+	want := []string{
+		"2025-03-12", "2025-03-14", "2025-03-15", // After the first Sunday in Lent (March 9)
+		"2025-06-11", "2025-06-13", "2025-06-14", // After Pentecost (June 8)
+		"2025-09-17", "2025-09-19", "2025-09-20", // After Holy Cross Day, a Sunday
+		"2025-12-17", "2025-12-19", "2025-12-20", // After St. Lucy's Day, a Saturday
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("EmberDays(2025) = %v, want %v", got, want)
+	}
+}
+
+func TestRogationDays(t *testing.T) {
+	// Ascension 2025 is May 29
+	var got []string
+	for _, d := range RogationDays(2025) {
+		got = append(got, d.Format("2006-01-02"))
+	}
+	if want := "2025-05-26 2025-05-27 2025-05-28"; strings.Join(got, " ") != want {
+		t.Errorf("RogationDays(2025) = %v, want %s", got, want)
+	}
+}
+
+func TestObservanceOn(t *testing.T) {
+	tests := []struct {
+		date   string
+		want   string
+		wantOK bool
+	}{
+		{"2025-06-11", "Ember Wednesday", true},
+		{"2025-06-13", "Ember Friday", true},
+		{"2025-06-14", "Ember Saturday", true},
+		{"2025-06-12", "", false},
+		{"2025-05-26", "Rogation Monday", true},
+		{"2025-05-28", "Rogation Wednesday", true},
+		{"2025-05-29", "", false}, // Ascension itself
+	}
+
+	for _, tt := range tests {
+		date, _ := time.Parse("2006-01-02", tt.date)
+		got, ok := ObservanceOn(date)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ObservanceOn(%s) = %q, %v, want %q, %v", tt.date, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestNormalizeToMidnight_TimeOfDayIgnored(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	// March 9, 2025 is both the first Sunday in Lent and the day US clocks
+	// spring forward; April 20 is Easter
+	for _, date := range []string{"2025-03-09", "2025-04-20", "2025-11-30"} {
+		day, _ := time.Parse("2006-01-02", date)
+		base := NormalizeToMidnight(day)
+
+		for _, at := range []time.Time{
+			time.Date(day.Year(), day.Month(), day.Day(), 1, 0, 0, 0, newYork),
+			time.Date(day.Year(), day.Month(), day.Day(), 23, 0, 0, 0, newYork),
+			time.Date(day.Year(), day.Month(), day.Day(), 23, 59, 59, 0, time.UTC),
+		} {
+			if got := NormalizeToMidnight(at); !got.Equal(base) {
+				t.Errorf("NormalizeToMidnight(%v) = %v, want %v", at, got, base)
+			}
+			if got, want := SeasonOf(at).Key, SeasonOf(base).Key; got != want {
+				t.Errorf("SeasonOf(%v) = %s, want %s", at, got, want)
+			}
+			if got, want := LiturgicalYearOf(at), LiturgicalYearOf(base); got != want {
+				t.Errorf("LiturgicalYearOf(%v) = %d, want %d", at, got, want)
+			}
+			gotFeast, _ := FeastOn(at)
+			wantFeast, _ := FeastOn(base)
+			if gotFeast.Key != wantFeast.Key {
+				t.Errorf("FeastOn(%v) = %q, want %q", at, gotFeast.Key, wantFeast.Key)
+			}
+		}
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       int
+	}{
+		{"same day", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 1, 23, 59, 0, 0, time.UTC), 0},
+		{"late to early next day", time.Date(2025, 6, 1, 23, 0, 0, 0, time.UTC), time.Date(2025, 6, 2, 1, 0, 0, 0, time.UTC), 1},
+		{"across spring forward", time.Date(2025, 3, 8, 12, 0, 0, 0, newYork), time.Date(2025, 3, 10, 0, 30, 0, 0, newYork), 2},
+		{"across fall back", time.Date(2025, 11, 1, 23, 0, 0, 0, newYork), time.Date(2025, 11, 3, 0, 0, 0, 0, newYork), 2},
+		{"mixed zones use each calendar date", time.Date(2025, 1, 1, 8, 0, 0, 0, tokyo), time.Date(2025, 1, 1, 20, 0, 0, 0, newYork), 0},
+		{"backwards", time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 18, 0, 0, 0, newYork), -7},
+		{"Easter to Pentecost", CalculateEaster(2025), CalculatePentecost(2025), DaysFromEasterToPentecost},
+	}
+
+	for _, tt := range tests {
+		if got := DaysBetween(tt.start, tt.end); got != tt.want {
+			t.Errorf("%s: DaysBetween = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	// Weeks counted from an anchor are stable at any time of day
+	advent := CalculateAdvent(2025)
+	for _, hour := range []int{0, 1, 12, 23} {
+		day := time.Date(2025, 12, 20, hour, 0, 0, 0, newYork)
+		if week := DaysBetween(advent, day)/7 + 1; week != 3 {
+			t.Errorf("week of Advent at %02d:00 = %d, want 3", hour, week)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package calendar
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Gregorian calendar limits for feast calculations.
+const (
+	// MinYear is the first year the Gregorian computus is valid for.
+	MinYear = 1583
+
+	// MaxYear is the last year dates are computed for, the end of the
+	// range the computus tables are usually published and checked over.
+	MaxYear = 4099
+)
+
+// Computus selects how Easter, and the feasts dated from it, are computed.
+type Computus string
+
+const (
+	// Western uses the Gregorian computus.
+	Western Computus = "western"
+
+	// Orthodox uses the Julian computus (Pascha), reported as Gregorian dates.
+	Orthodox Computus = "orthodox"
+)
+
+// ParseComputus parses a calendar name ("western" or "orthodox",
+// case-insensitive). An empty string is Western.
+func ParseComputus(s string) (Computus, bool) {
+	switch Computus(strings.ToLower(strings.TrimSpace(s))) {
+	case "", Western:
+		return Western, true
+	case Orthodox:
+		return Orthodox, true
+	}
+	return "", false
+}
+
+// Feast describes a principal feast of the church year.
+type Feast struct {
+	Key      string                   // URL slug, e.g. "christmas"
+	Name     string                   // Display name, e.g. "Christmas Day"
+	Date     func(year int) time.Time // Date of the feast in a calendar year
+	HasVigil bool                     // Whether the feast is preceded by eve/vigil readings
+	Movable  bool                     // Whether the feast is dated from Easter
+
+	computus Computus // Calendar Date was built for; zero means Western
+}
+
+// In returns the feast dated by the given computus. Movable feasts shift
+// by the difference between that computus' Easter and Western Easter;
+// fixed feasts are unchanged.
+func (f Feast) In(c Computus) Feast {
+	f.computus = c
+	if !f.Movable || c != Orthodox {
+		return f
+	}
+
+	western := f.Date
+	f.Date = func(year int) time.Time {
+		shift := DaysBetween(CalculateEaster(year), CalculateOrthodoxEaster(year))
+		return western(year).AddDate(0, 0, shift)
+	}
+	return f
+}
+
+// Eve returns the date of the feast's eve (the day before) in the given
+// calendar year. The second return value is false if the feast has no vigil.
+func (f Feast) Eve(year int) (time.Time, bool) {
+	if !f.HasVigil {
+		return time.Time{}, false
+	}
+	return f.Date(year).AddDate(0, 0, -1), true
+}
+
+// NextOccurrence returns the first date on or after from that the feast
+// falls on. Only the calendar date of from is considered; the result is
+// midnight UTC.
+func (f Feast) NextOccurrence(from time.Time) time.Time {
+	day := NormalizeToMidnight(from)
+
+	next := f.Date(day.Year())
+	if next.Before(day) {
+		next = f.Date(day.Year() + 1)
+	}
+	return next
+}
+
+// InLiturgicalYear returns the date of the feast within the liturgical
+// year that begins at Advent of the given calendar year: Advent and
+// Christmas fall in that year, the other feasts in the next.
+func (f Feast) InLiturgicalYear(year int) time.Time {
+	start, _ := LiturgicalYearBounds(year)
+	if date := f.Date(year); !date.Before(start) {
+		return date
+	}
+	return f.Date(year + 1)
+}
+
+// fixedDate returns a Date func for a feast on the same month/day every year.
+func fixedDate(month time.Month, day int) func(int) time.Time {
+	return func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Feasts lists the principal feasts in calendar-year order.
+var Feasts = []Feast{
+	{Key: "epiphany", Name: "Epiphany of the Lord", Date: fixedDate(time.January, 6), HasVigil: true},
+	{Key: "ash-wednesday", Name: "Ash Wednesday", Date: CalculateAshWednesday, Movable: true},
+	{Key: "palm-sunday", Name: "Palm Sunday", Date: CalculatePalmSunday, Movable: true},
+	{Key: "easter", Name: "Easter Day", Date: CalculateEaster, HasVigil: true, Movable: true},
+	{Key: "ascension", Name: "Ascension of the Lord", Date: CalculateAscension, Movable: true},
+	{Key: "pentecost", Name: "Day of Pentecost", Date: CalculatePentecost, HasVigil: true, Movable: true},
+	{Key: "advent", Name: "First Sunday of Advent", Date: CalculateAdvent},
+	{Key: "christmas", Name: "Christmas Day", Date: fixedDate(time.December, 25), HasVigil: true},
+}
+
+// relation links a feast to a relative. yearOffset places the relative in
+// the right calendar year, e.g. the Epiphany after Christmas is in year+1.
+type relation struct {
+	key        string
+	yearOffset int
+}
+
+// relatedFeasts is the cross-reference graph between feasts: the Easter
+// cycle (Lent through Pentecost) and the Christmas cycle (Advent through
+// Epiphany).
+var relatedFeasts = map[string][]relation{
+	"advent":        {{"christmas", 0}, {"epiphany", 1}},
+	"christmas":     {{"advent", 0}, {"epiphany", 1}},
+	"epiphany":      {{"advent", -1}, {"christmas", -1}},
+	"ash-wednesday": {{"palm-sunday", 0}, {"easter", 0}},
+	"palm-sunday":   {{"ash-wednesday", 0}, {"easter", 0}},
+	"easter":        {{"ash-wednesday", 0}, {"palm-sunday", 0}, {"ascension", 0}, {"pentecost", 0}},
+	"ascension":     {{"easter", 0}, {"pentecost", 0}},
+	"pentecost":     {{"easter", 0}, {"ascension", 0}},
+}
+
+// RelatedFeast is a feast connected to another, dated relative to the
+// year of the feast it was looked up from.
+type RelatedFeast struct {
+	Feast
+	On time.Time // Date of the related feast
+}
+
+// Related returns the feasts connected to f, dated relative to f's
+// occurrence in the given year and by the same computus, in chronological
+// order.
+func (f Feast) Related(year int) []RelatedFeast {
+	var related []RelatedFeast
+	for _, rel := range relatedFeasts[f.Key] {
+		feast, ok := LookupFeast(rel.key)
+		if !ok {
+			continue
+		}
+		feast = feast.In(f.computus)
+		related = append(related, RelatedFeast{
+			Feast: feast,
+			On:    feast.Date(year + rel.yearOffset),
+		})
+	}
+
+	sort.Slice(related, func(i, j int) bool { return related[i].On.Before(related[j].On) })
+	return related
+}
+
+// LookupFeast finds a feast by its key or display name (case-insensitive;
+// spaces and hyphens are interchangeable, so "Ash Wednesday" and
+// "christmas day" match).
+func LookupFeast(key string) (Feast, bool) {
+	key = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(key)), " ", "-")
+	for _, f := range Feasts {
+		if f.Key == key || strings.ReplaceAll(strings.ToLower(f.Name), " ", "-") == key {
+			return f, true
+		}
+	}
+	return Feast{}, false
+}
+
+// FeastOn returns the principal feast falling on a date, if any.
+func FeastOn(date time.Time) (Feast, bool) {
+	day := NormalizeToMidnight(date)
+	for _, f := range Feasts {
+		if f.Date(day.Year()).Equal(day) {
+			return f, true
+		}
+	}
+	return Feast{}, false
+}
+
+// Sundays returns every Sunday from start through end (inclusive), at
+// midnight UTC.
+func Sundays(start, end time.Time) []time.Time {
+	day := NormalizeToMidnight(start)
+	last := NormalizeToMidnight(end)
+
+	// Advance to the first Sunday
+	day = day.AddDate(0, 0, (7-int(day.Weekday()))%7)
+
+	var sundays []time.Time
+	for ; !day.After(last); day = day.AddDate(0, 0, 7) {
+		sundays = append(sundays, day)
+	}
+	return sundays
+}
+
+// LiturgicalYearBounds returns the first and last day of the liturgical
+// year that begins at Advent of the given calendar year: the First Sunday
+// of Advent through the Saturday before the next Advent.
+func LiturgicalYearBounds(year int) (start, end time.Time) {
+	return CalculateAdvent(year), CalculateAdvent(year+1).AddDate(0, 0, -1)
+}
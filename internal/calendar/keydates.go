@@ -0,0 +1,57 @@
+package calendar
+
+import (
+	"sync"
+	"time"
+)
+
+// KeyDates are the movable anchor dates of one calendar year. Advent and
+// Christ the King are those at the end of the year.
+type KeyDates struct {
+	BaptismOfTheLord time.Time
+	AshWednesday     time.Time
+	PalmSunday       time.Time
+	Easter           time.Time
+	Ascension        time.Time
+	Pentecost        time.Time
+	TrinitySunday    time.Time
+	ChristTheKing    time.Time
+	Advent           time.Time
+}
+
+// keyDatesCache maps a year to its *KeyDates. Entries never change, so
+// nothing is invalidated; only years from MinYear to MaxYear are stored,
+// which bounds its size.
+var keyDatesCache sync.Map
+
+// KeyDatesFor returns the anchor dates of a calendar year, computing them
+// on first use and caching them for later calls. Safe for concurrent use.
+func KeyDatesFor(year int) KeyDates {
+	if v, ok := keyDatesCache.Load(year); ok {
+		return *v.(*KeyDates)
+	}
+
+	kd := computeKeyDates(year)
+	if year >= MinYear && year <= MaxYear {
+		keyDatesCache.Store(year, &kd)
+	}
+	return kd
+}
+
+// computeKeyDates computes a year's anchor dates from one Easter and one
+// Advent calculation.
+func computeKeyDates(year int) KeyDates {
+	easter := CalculateEaster(year)
+	advent := CalculateAdvent(year)
+	return KeyDates{
+		BaptismOfTheLord: CalculateBaptismOfTheLord(year),
+		AshWednesday:     easter.AddDate(0, 0, -DaysFromEasterToAshWednesday),
+		PalmSunday:       easter.AddDate(0, 0, -DaysFromEasterToPalmSunday),
+		Easter:           easter,
+		Ascension:        easter.AddDate(0, 0, DaysFromEasterToAscension),
+		Pentecost:        easter.AddDate(0, 0, DaysFromEasterToPentecost),
+		TrinitySunday:    easter.AddDate(0, 0, DaysFromEasterToTrinity),
+		ChristTheKing:    advent.AddDate(0, 0, -7),
+		Advent:           advent,
+	}
+}
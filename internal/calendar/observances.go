@@ -0,0 +1,69 @@
+package calendar
+
+import "time"
+
+// emberDayNames and rogationDayNames name the days of each group in order.
+var (
+	emberDayNames    = []string{"Ember Wednesday", "Ember Friday", "Ember Saturday"}
+	rogationDayNames = []string{"Rogation Monday", "Rogation Tuesday", "Rogation Wednesday"}
+)
+
+// wednesdayAfter returns the first Wednesday strictly after date.
+func wednesdayAfter(date time.Time) time.Time {
+	days := (int(time.Wednesday) - int(date.Weekday()) + 7) % 7
+	if days == 0 {
+		days = 7
+	}
+	return date.AddDate(0, 0, days)
+}
+
+// EmberDays returns the twelve Ember Days of a calendar year in date
+// order: the Wednesday, Friday, and Saturday after the first Sunday in
+// Lent, after Pentecost, after Holy Cross Day (September 14), and after
+// St. Lucy's Day (December 13).
+func EmberDays(year int) []time.Time {
+	kd := KeyDatesFor(year)
+	anchors := []time.Time{
+		kd.AshWednesday.AddDate(0, 0, 4), // First Sunday in Lent
+		kd.Pentecost,
+		time.Date(year, time.September, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 13, 0, 0, 0, 0, time.UTC),
+	}
+
+	days := make([]time.Time, 0, 12)
+	for _, anchor := range anchors {
+		wed := wednesdayAfter(anchor)
+		days = append(days, wed, wed.AddDate(0, 0, 2), wed.AddDate(0, 0, 3))
+	}
+	return days
+}
+
+// RogationDays returns the three Rogation Days of a calendar year: the
+// Monday, Tuesday, and Wednesday before Ascension.
+func RogationDays(year int) []time.Time {
+	ascension := KeyDatesFor(year).Ascension
+	return []time.Time{
+		ascension.AddDate(0, 0, -3),
+		ascension.AddDate(0, 0, -2),
+		ascension.AddDate(0, 0, -1),
+	}
+}
+
+// ObservanceOn returns the name of the Ember or Rogation Day falling on
+// date, such as "Ember Friday" or "Rogation Monday". These are only kept
+// by some traditions, so callers decide whether to show them.
+func ObservanceOn(date time.Time) (string, bool) {
+	day := NormalizeToMidnight(date)
+
+	for i, ember := range EmberDays(day.Year()) {
+		if ember.Equal(day) {
+			return emberDayNames[i%3], true
+		}
+	}
+	for i, rogation := range RogationDays(day.Year()) {
+		if rogation.Equal(day) {
+			return rogationDayNames[i], true
+		}
+	}
+	return "", false
+}
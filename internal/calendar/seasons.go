@@ -0,0 +1,103 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+)
+
+// Span is an inclusive run of days.
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Season is a season of the liturgical year.
+type Season struct {
+	Key  string // URL slug, e.g. "ordinary-time"
+	Name string // Display name, e.g. "Ordinary Time"
+
+	// spans returns the season's days within the liturgical year that
+	// begins at Advent of the given calendar year
+	spans func(year int) []Span
+}
+
+// Spans returns the runs of days the season covers in the liturgical year
+// that begins at Advent of the given calendar year, in date order. Most
+// seasons are one span; Ordinary Time is two (after the Baptism of the
+// Lord, and after Pentecost).
+func (s Season) Spans(year int) []Span {
+	return s.spans(year)
+}
+
+// CalculateBaptismOfTheLord returns the Baptism of the Lord for a calendar
+// year: the first Sunday after Epiphany (January 7-13).
+func CalculateBaptismOfTheLord(year int) time.Time {
+	jan7 := time.Date(year, time.January, 7, 0, 0, 0, 0, time.UTC)
+	return jan7.AddDate(0, 0, (7-int(jan7.Weekday()))%7)
+}
+
+// Seasons lists the seasons in liturgical-year order.
+var Seasons = []Season{
+	{Key: "advent", Name: "Advent", spans: func(year int) []Span {
+		return []Span{{KeyDatesFor(year).Advent, time.Date(year, time.December, 24, 0, 0, 0, 0, time.UTC)}}
+	}},
+	{Key: "christmas", Name: "Christmas", spans: func(year int) []Span {
+		return []Span{{time.Date(year, time.December, 25, 0, 0, 0, 0, time.UTC), time.Date(year+1, time.January, 5, 0, 0, 0, 0, time.UTC)}}
+	}},
+	{Key: "epiphany", Name: "Epiphany", spans: func(year int) []Span {
+		return []Span{{time.Date(year+1, time.January, 6, 0, 0, 0, 0, time.UTC), KeyDatesFor(year+1).BaptismOfTheLord.AddDate(0, 0, -1)}}
+	}},
+	{Key: "lent", Name: "Lent", spans: func(year int) []Span {
+		next := KeyDatesFor(year + 1)
+		return []Span{{next.AshWednesday, next.Easter.AddDate(0, 0, -1)}}
+	}},
+	{Key: "easter", Name: "Easter", spans: func(year int) []Span {
+		next := KeyDatesFor(year + 1)
+		return []Span{{next.Easter, next.Pentecost}}
+	}},
+	{Key: "ordinary-time", Name: "Ordinary Time", spans: func(year int) []Span {
+		next := KeyDatesFor(year + 1)
+		return []Span{
+			{next.BaptismOfTheLord, next.AshWednesday.AddDate(0, 0, -1)},
+			{next.Pentecost.AddDate(0, 0, 1), next.Advent.AddDate(0, 0, -1)},
+		}
+	}},
+}
+
+// LookupSeason finds a season by key or name (case-insensitive; spaces
+// and hyphens are interchangeable, so "Ordinary Time" matches).
+func LookupSeason(name string) (Season, bool) {
+	key := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "-")
+	for _, s := range Seasons {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return Season{}, false
+}
+
+// LiturgicalYearOf returns the calendar year in which the liturgical year
+// containing date began (the year of its First Sunday of Advent).
+func LiturgicalYearOf(date time.Time) int {
+	day := NormalizeToMidnight(date)
+	if day.Before(KeyDatesFor(day.Year()).Advent) {
+		return day.Year() - 1
+	}
+	return day.Year()
+}
+
+// SeasonOf returns the season a date falls in. Every day belongs to
+// exactly one season.
+func SeasonOf(date time.Time) Season {
+	day := NormalizeToMidnight(date)
+	year := LiturgicalYearOf(day)
+	for _, s := range Seasons {
+		for _, span := range s.Spans(year) {
+			if !day.Before(span.Start) && !day.After(span.End) {
+				return s
+			}
+		}
+	}
+	// Unreachable: the spans cover the whole liturgical year
+	return Season{}
+}
@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -26,6 +29,31 @@ type Config struct {
 	// Logging
 	LogLevel  string // debug, info, warn, error
 	LogFormat string // json, text
+
+	// Routing
+	TrailingSlash string // redirect, rewrite, strict
+
+	// CORS
+	CORSAllowedOrigins   []string // Origins allowed to call the API; empty = any ("*")
+	CORSAllowCredentials bool     // Let allowed origins send cookies/auth; needs CORSAllowedOrigins
+	CORSMaxAge           int      // Seconds browsers may cache preflight results; 0 = browser default
+
+	// Calendar
+	FeastCalendar   string // Computus for Easter-based feasts: western, orthodox
+	DefaultTimezone string // IANA zone for "today" when a request sends no X-Timezone
+	EmberRogation   bool   // Name Ember and Rogation Days in calendar and feed exports
+
+	// Testing and demos
+	OverrideToday string // Fixed YYYY-MM-DD used as "today"; not allowed in production
+
+	// Limits
+	MaxHeavyConcurrency int  // Concurrent heavy requests (range, book, snapshot); 0 = unlimited
+	RateLimitPerMinute  int  // Public API requests per minute per client IP; 0 = unlimited
+	RateLimitBurst      int  // Requests a client may make back to back before the rate applies
+	TrustProxy          bool // Take client IPs from X-Forwarded-For; only behind a reverse proxy
+
+	// Observability
+	MetricsEnabled bool // Serve Prometheus metrics at /metrics
 }
 
 // Environment constants
@@ -35,6 +63,24 @@ const (
 	EnvProduction  = "production"
 )
 
+// Trailing slash handling modes
+const (
+	// TrailingSlashRedirect redirects "/path/" to "/path" with a 308.
+	TrailingSlashRedirect = "redirect"
+
+	// TrailingSlashRewrite serves "/path/" as if "/path" had been requested.
+	TrailingSlashRewrite = "rewrite"
+
+	// TrailingSlashStrict treats "/path/" as a distinct (usually unknown) path.
+	TrailingSlashStrict = "strict"
+)
+
+// Feast calendars (how Easter and the feasts dated from it are computed)
+const (
+	FeastCalendarWestern  = "western"
+	FeastCalendarOrthodox = "orthodox"
+)
+
 // Load reads configuration from environment variables.
 // In development, it first loads from .env file if present.
 func Load() (*Config, error) {
@@ -53,12 +99,36 @@ func Load() (*Config, error) {
 
 	// Authentication
 	cfg.AdminAPIKey = getEnv("ADMIN_API_KEY", "")
-	fmt.Println(cfg.AdminAPIKey)
 
 	// Logging
 	cfg.LogLevel = getEnv("LOG_LEVEL", "info")
 	cfg.LogFormat = getEnv("LOG_FORMAT", "text")
 
+	// Routing
+	cfg.TrailingSlash = getEnv("TRAILING_SLASH", TrailingSlashRedirect)
+
+	// CORS
+	cfg.CORSAllowedOrigins = getEnvList("CORS_ALLOWED_ORIGINS")
+	cfg.CORSAllowCredentials = getEnvBool("CORS_ALLOW_CREDENTIALS", false)
+	cfg.CORSMaxAge = getEnvInt("CORS_MAX_AGE", 3600)
+
+	// Calendar
+	cfg.FeastCalendar = getEnv("FEAST_CALENDAR", FeastCalendarWestern)
+	cfg.DefaultTimezone = getEnv("DEFAULT_TIMEZONE", "UTC")
+	cfg.EmberRogation = getEnvBool("EMBER_ROGATION_DAYS", false)
+
+	// Testing and demos
+	cfg.OverrideToday = getEnv("OVERRIDE_TODAY", "")
+
+	// Limits
+	cfg.MaxHeavyConcurrency = getEnvInt("MAX_HEAVY_CONCURRENCY", 4)
+	cfg.RateLimitPerMinute = getEnvInt("RATE_LIMIT_PER_MINUTE", 120)
+	cfg.RateLimitBurst = getEnvInt("RATE_LIMIT_BURST", 20)
+	cfg.TrustProxy = getEnvBool("TRUST_PROXY", false)
+
+	// Observability
+	cfg.MetricsEnabled = getEnvBool("METRICS_ENABLED", true)
+
 	// Validate configuration
 	if err := cfg.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
@@ -115,6 +185,59 @@ func (c *Config) Validate() error {
 		errs = append(errs, fmt.Errorf("LOG_FORMAT must be one of: json, text; got %q", c.LogFormat))
 	}
 
+	// Validate trailing slash mode (empty behaves like strict)
+	switch c.TrailingSlash {
+	case "", TrailingSlashRedirect, TrailingSlashRewrite, TrailingSlashStrict:
+		// Valid
+	default:
+		errs = append(errs, fmt.Errorf("TRAILING_SLASH must be one of: redirect, rewrite, strict; got %q", c.TrailingSlash))
+	}
+
+	// Credentials can't be combined with the "*" any-origin policy
+	if c.CORSAllowCredentials && (len(c.CORSAllowedOrigins) == 0 || slices.Contains(c.CORSAllowedOrigins, "*")) {
+		errs = append(errs, errors.New("CORS_ALLOW_CREDENTIALS requires CORS_ALLOWED_ORIGINS to list specific origins"))
+	}
+
+	// Validate CORS preflight cache duration
+	if c.CORSMaxAge < 0 {
+		errs = append(errs, fmt.Errorf("CORS_MAX_AGE must not be negative, got %d", c.CORSMaxAge))
+	}
+
+	// Validate feast calendar (empty behaves like western)
+	switch c.FeastCalendar {
+	case "", FeastCalendarWestern, FeastCalendarOrthodox:
+		// Valid
+	default:
+		errs = append(errs, fmt.Errorf("FEAST_CALENDAR must be one of: western, orthodox; got %q", c.FeastCalendar))
+	}
+
+	// Validate default timezone (empty behaves like UTC)
+	if _, err := time.LoadLocation(c.DefaultTimezone); err != nil {
+		errs = append(errs, fmt.Errorf("DEFAULT_TIMEZONE must be an IANA time zone such as America/Chicago, got %q", c.DefaultTimezone))
+	}
+
+	// Today override is for deterministic demos and tests only
+	if c.OverrideToday != "" {
+		if c.Env == EnvProduction {
+			errs = append(errs, errors.New("OVERRIDE_TODAY is not allowed in production"))
+		} else if _, err := time.Parse("2006-01-02", c.OverrideToday); err != nil {
+			errs = append(errs, fmt.Errorf("OVERRIDE_TODAY must be a date in YYYY-MM-DD format, got %q", c.OverrideToday))
+		}
+	}
+
+	// Validate heavy request limit (0 disables it)
+	if c.MaxHeavyConcurrency < 0 {
+		errs = append(errs, fmt.Errorf("MAX_HEAVY_CONCURRENCY must not be negative, got %d", c.MaxHeavyConcurrency))
+	}
+
+	// Validate rate limiting (0 disables it)
+	if c.RateLimitPerMinute < 0 {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_PER_MINUTE must not be negative, got %d", c.RateLimitPerMinute))
+	}
+	if c.RateLimitBurst < 0 {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_BURST must not be negative, got %d", c.RateLimitBurst))
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -132,6 +255,29 @@ func (c *Config) IsProduction() bool {
 	return c.Env == EnvProduction
 }
 
+// FixedToday returns the OVERRIDE_TODAY date at midnight UTC, if one is set
+// and usable. It always reports false in production.
+func (c *Config) FixedToday() (time.Time, bool) {
+	if c.OverrideToday == "" || c.IsProduction() {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02", c.OverrideToday)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// DefaultLocation returns the DEFAULT_TIMEZONE location, or UTC if it is
+// unset or invalid.
+func (c *Config) DefaultLocation() *time.Location {
+	loc, err := time.LoadLocation(c.DefaultTimezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // getEnv reads an environment variable with a default fallback.
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -140,6 +286,18 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvList reads a comma-separated environment variable into a slice,
+// trimming whitespace and dropping empty entries. Returns nil if unset.
+func getEnvList(key string) []string {
+	var values []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // getEnvInt reads an environment variable as an integer with a default fallback.
 func getEnvInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
@@ -149,3 +307,14 @@ func getEnvInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvBool reads an environment variable as a boolean with a default fallback.
+// Accepts the values strconv.ParseBool does (1, true, 0, false, ...).
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 func TestLoad_Defaults(t *testing.T) {
@@ -25,6 +26,36 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.LogFormat != "text" {
 		t.Errorf("LogFormat = %q, want %q", cfg.LogFormat, "text")
 	}
+	if cfg.TrailingSlash != TrailingSlashRedirect {
+		t.Errorf("TrailingSlash = %q, want %q", cfg.TrailingSlash, TrailingSlashRedirect)
+	}
+	if cfg.MaxHeavyConcurrency != 4 {
+		t.Errorf("MaxHeavyConcurrency = %d, want 4", cfg.MaxHeavyConcurrency)
+	}
+	if cfg.RateLimitPerMinute != 120 || cfg.RateLimitBurst != 20 {
+		t.Errorf("RateLimitPerMinute, RateLimitBurst = %d, %d, want 120, 20", cfg.RateLimitPerMinute, cfg.RateLimitBurst)
+	}
+	if cfg.TrustProxy {
+		t.Error("TrustProxy = true, want false")
+	}
+	if !cfg.MetricsEnabled {
+		t.Error("MetricsEnabled = false, want true")
+	}
+	if cfg.CORSAllowedOrigins != nil {
+		t.Errorf("CORSAllowedOrigins = %v, want nil", cfg.CORSAllowedOrigins)
+	}
+	if cfg.CORSMaxAge != 3600 {
+		t.Errorf("CORSMaxAge = %d, want 3600", cfg.CORSMaxAge)
+	}
+	if cfg.FeastCalendar != FeastCalendarWestern {
+		t.Errorf("FeastCalendar = %q, want %q", cfg.FeastCalendar, FeastCalendarWestern)
+	}
+	if cfg.EmberRogation {
+		t.Error("EmberRogation = true, want false")
+	}
+	if cfg.DefaultLocation() != time.UTC {
+		t.Errorf("DefaultLocation() = %v, want UTC", cfg.DefaultLocation())
+	}
 }
 
 func TestLoad_FromEnv(t *testing.T) {
@@ -36,6 +67,8 @@ func TestLoad_FromEnv(t *testing.T) {
 	os.Setenv("ADMIN_API_KEY", "admin-secure-key-32-characters-long")
 	os.Setenv("LOG_LEVEL", "debug")
 	os.Setenv("LOG_FORMAT", "json")
+	os.Setenv("CORS_ALLOWED_ORIGINS", "https://a.example, https://b.example,")
+	os.Setenv("CORS_MAX_AGE", "600")
 	defer clearEnv()
 
 	cfg, err := Load()
@@ -61,6 +94,12 @@ func TestLoad_FromEnv(t *testing.T) {
 	if cfg.LogFormat != "json" {
 		t.Errorf("LogFormat = %q, want %q", cfg.LogFormat, "json")
 	}
+	if len(cfg.CORSAllowedOrigins) != 2 || cfg.CORSAllowedOrigins[1] != "https://b.example" {
+		t.Errorf("CORSAllowedOrigins = %v, want [https://a.example https://b.example]", cfg.CORSAllowedOrigins)
+	}
+	if cfg.CORSMaxAge != 600 {
+		t.Errorf("CORSMaxAge = %d, want 600", cfg.CORSMaxAge)
+	}
 }
 
 func TestConfig_Validate(t *testing.T) {
@@ -172,6 +211,152 @@ func TestConfig_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalid trailing slash mode",
+			config: Config{
+				Port:          8080,
+				Env:           EnvDevelopment,
+				DatabasePath:  "./data/test.db",
+				LogLevel:      "info",
+				LogFormat:     "text",
+				TrailingSlash: "ignore", // Not valid
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid feast calendar",
+			config: Config{
+				Port:          8080,
+				Env:           EnvDevelopment,
+				DatabasePath:  "./data/test.db",
+				LogLevel:      "info",
+				LogFormat:     "text",
+				FeastCalendar: "julian", // Not valid
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid default timezone",
+			config: Config{
+				Port:            8080,
+				Env:             EnvDevelopment,
+				DatabasePath:    "./data/test.db",
+				LogLevel:        "info",
+				LogFormat:       "text",
+				DefaultTimezone: "America/Chicago",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid default timezone",
+			config: Config{
+				Port:            8080,
+				Env:             EnvDevelopment,
+				DatabasePath:    "./data/test.db",
+				LogLevel:        "info",
+				LogFormat:       "text",
+				DefaultTimezone: "Central Time", // Not an IANA zone
+			},
+			wantErr: true,
+		},
+		{
+			name: "today override in development",
+			config: Config{
+				Port:          8080,
+				Env:           EnvDevelopment,
+				DatabasePath:  "./data/test.db",
+				LogLevel:      "info",
+				LogFormat:     "text",
+				OverrideToday: "2025-12-25",
+			},
+			wantErr: false,
+		},
+		{
+			name: "today override in production",
+			config: Config{
+				Port:          8080,
+				Env:           EnvProduction,
+				DatabasePath:  "./data/test.db",
+				AdminAPIKey:   "admin-secure-key-32-characters-long",
+				LogLevel:      "info",
+				LogFormat:     "text",
+				OverrideToday: "2025-12-25", // Not allowed
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed today override",
+			config: Config{
+				Port:          8080,
+				Env:           EnvStaging,
+				DatabasePath:  "./data/test.db",
+				LogLevel:      "info",
+				LogFormat:     "text",
+				OverrideToday: "12/25/2025", // Not valid
+			},
+			wantErr: true,
+		},
+		{
+			name: "CORS credentials with listed origins",
+			config: Config{
+				Port:                 8080,
+				Env:                  EnvDevelopment,
+				DatabasePath:         "./data/test.db",
+				LogLevel:             "info",
+				LogFormat:            "text",
+				CORSAllowedOrigins:   []string{"https://app.example"},
+				CORSAllowCredentials: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "CORS credentials with any origin",
+			config: Config{
+				Port:                 8080,
+				Env:                  EnvDevelopment,
+				DatabasePath:         "./data/test.db",
+				LogLevel:             "info",
+				LogFormat:            "text",
+				CORSAllowCredentials: true, // Not valid without an allowlist
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative CORS max age",
+			config: Config{
+				Port:         8080,
+				Env:          EnvDevelopment,
+				DatabasePath: "./data/test.db",
+				LogLevel:     "info",
+				LogFormat:    "text",
+				CORSMaxAge:   -1, // Not valid
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative heavy concurrency",
+			config: Config{
+				Port:                8080,
+				Env:                 EnvDevelopment,
+				DatabasePath:        "./data/test.db",
+				LogLevel:            "info",
+				LogFormat:           "text",
+				MaxHeavyConcurrency: -1, // Not valid
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative rate limit",
+			config: Config{
+				Port:               8080,
+				Env:                EnvDevelopment,
+				DatabasePath:       "./data/test.db",
+				LogLevel:           "info",
+				LogFormat:          "text",
+				RateLimitPerMinute: -1, // Not valid
+			},
+			wantErr: true,
+		},
 		{
 			name: "empty database path",
 			config: Config{
@@ -223,7 +408,11 @@ func TestConfig_IsProduction(t *testing.T) {
 func clearEnv() {
 	vars := []string{
 		"PORT", "ENV", "DATABASE_PATH", "ADMIN_API_KEY",
-		"LOG_LEVEL", "LOG_FORMAT",
+		"LOG_LEVEL", "LOG_FORMAT", "TRAILING_SLASH",
+		"MAX_HEAVY_CONCURRENCY", "CORS_ALLOWED_ORIGINS", "CORS_ALLOW_CREDENTIALS", "CORS_MAX_AGE",
+		"OVERRIDE_TODAY", "FEAST_CALENDAR", "DEFAULT_TIMEZONE", "EMBER_ROGATION_DAYS",
+		"RATE_LIMIT_PER_MINUTE", "RATE_LIMIT_BURST", "TRUST_PROXY",
+		"METRICS_ENABLED",
 	}
 	for _, v := range vars {
 		os.Unsetenv(v)
@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3" // SQLite driver
@@ -21,7 +22,13 @@ import (
 // DB wraps the standard sql.DB with lectionary-specific methods.
 type DB struct {
 	*sql.DB
+	path   string
 	logger *slog.Logger
+
+	// Background work started with Go; Close waits for it
+	bgMu     sync.Mutex
+	bgClosed bool
+	bg       sync.WaitGroup
 }
 
 // Config holds database configuration options.
@@ -97,16 +104,41 @@ func Open(cfg Config, logger *slog.Logger) (*DB, error) {
 
 	return &DB{
 		DB:     db,
+		path:   cfg.Path,
 		logger: logger,
 	}, nil
 }
 
-// Close closes the database connection.
+// Close closes the database connection once background work started with
+// Go has finished. Go starts nothing new after Close is called.
 func (db *DB) Close() error {
+	db.bgMu.Lock()
+	db.bgClosed = true
+	db.bgMu.Unlock()
+	db.bg.Wait()
+
 	db.logger.Info("closing database connection")
 	return db.DB.Close()
 }
 
+// Go runs fn in a goroutine that Close waits for, for writes that
+// shouldn't hold up a request but must not outlive the database. Once
+// Close has been called fn is dropped, and Go reports false.
+func (db *DB) Go(fn func()) bool {
+	db.bgMu.Lock()
+	defer db.bgMu.Unlock()
+	if db.bgClosed {
+		return false
+	}
+
+	db.bg.Add(1)
+	go func() {
+		defer db.bg.Done()
+		fn()
+	}()
+	return true
+}
+
 // Health checks if the database connection is healthy.
 func (db *DB) Health(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
@@ -124,16 +156,99 @@ func (db *DB) Health(ctx context.Context) error {
 	return nil
 }
 
+// Path returns the database file path the connection was opened with.
+func (db *DB) Path() string {
+	return db.path
+}
+
+// JournalMode reports SQLite's journal mode, which should be "wal" for
+// file databases opened by Open ("memory" for in-memory ones).
+func (db *DB) JournalMode(ctx context.Context) (string, error) {
+	var mode string
+	if err := db.QueryRowContext(ctx, "PRAGMA journal_mode").Scan(&mode); err != nil {
+		return "", fmt.Errorf("query journal mode: %w", err)
+	}
+	return mode, nil
+}
+
+// MigrationStatus reports the highest applied migration version and the
+// latest version this build knows about. The database is fully migrated
+// when they are equal.
+func (db *DB) MigrationStatus(ctx context.Context) (applied, latest int, err error) {
+	err = db.QueryRowContext(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&applied)
+	if err != nil {
+		return 0, len(migrationsSQL), fmt.Errorf("query migration version: %w", err)
+	}
+	return applied, len(migrationsSQL), nil
+}
+
+// Backup writes a consistent, compacted copy of the database to destPath
+// using SQLite's VACUUM INTO.
+//
+// The destination file must not already exist. The copy is a standalone
+// SQLite database (no WAL files) holding everything, user data included;
+// use Snapshot for copies that leave the server.
+func (db *DB) Backup(ctx context.Context, destPath string) error {
+	if _, err := os.Stat(destPath); err == nil {
+		return fmt.Errorf("backup destination already exists: %s", destPath)
+	}
+
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", destPath); err != nil {
+		return fmt.Errorf("vacuum into %s: %w", destPath, err)
+	}
+
+	return nil
+}
+
+// privateTables hold user accounts, credentials, and users' own data.
+// Children come before the tables they reference.
+var privateTables = []string{"reading_plans", "reading_progress", "api_keys", "users"}
+
+// Snapshot writes a readings-only copy of the database to destPath for
+// bundling into offline clients. It is a Backup with every private table
+// emptied and the file vacuumed again, so no deleted rows linger in free
+// pages. The schema is kept, so the copy opens and migrates like any
+// other database.
+func (db *DB) Snapshot(ctx context.Context, destPath string) error {
+	if err := db.Backup(ctx, destPath); err != nil {
+		return err
+	}
+
+	snapshot, err := sql.Open("sqlite3", destPath)
+	if err != nil {
+		return fmt.Errorf("open snapshot: %w", err)
+	}
+	defer snapshot.Close()
+
+	for _, table := range privateTables {
+		if _, err := snapshot.ExecContext(ctx, "DELETE FROM "+table); err != nil {
+			return fmt.Errorf("clear %s from snapshot: %w", table, err)
+		}
+		// Don't reveal how many rows the table ever had
+		if _, err := snapshot.ExecContext(ctx, "DELETE FROM sqlite_sequence WHERE name = ?", table); err != nil {
+			return fmt.Errorf("reset %s sequence in snapshot: %w", table, err)
+		}
+	}
+
+	if _, err := snapshot.ExecContext(ctx, "VACUUM"); err != nil {
+		return fmt.Errorf("vacuum snapshot: %w", err)
+	}
+
+	return nil
+}
+
 // =============================================================================
 // Migrations
 // =============================================================================
 
 // Migrate runs all pending database migrations.
 //
-// This uses a simple forward-only migration strategy:
+// This uses a simple migration strategy:
 // 1. Check which migrations have been applied (via schema_migrations table)
 // 2. Apply any new ones in order
 //
+// Use Rollback to undo migrations during development.
+//
 // Returns the number of migrations applied.
 func (db *DB) Migrate(ctx context.Context) (int, error) {
 	db.logger.Info("running database migrations")
@@ -222,6 +337,79 @@ func (db *DB) Migrate(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// Rollback reverses applied migrations newer than toVersion, newest first,
+// using their down SQL. Everything happens in one transaction, so either
+// all the requested migrations are rolled back or none are.
+//
+// The baseline schema (migration 1) can't be rolled back. Returns the
+// number of migrations rolled back.
+func (db *DB) Rollback(ctx context.Context, toVersion int) (int, error) {
+	if toVersion < baselineMigration {
+		return 0, fmt.Errorf("cannot roll back below baseline migration %d", baselineMigration)
+	}
+
+	db.logger.Info("rolling back database migrations",
+		slog.Int("to_version", toVersion),
+	)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op if committed
+
+	rows, err := tx.QueryContext(ctx,
+		"SELECT version FROM schema_migrations WHERE version > ? ORDER BY version DESC",
+		toVersion,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("query applied migrations: %w", err)
+	}
+
+	var versions []int
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("scan migration version: %w", err)
+		}
+		versions = append(versions, version)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("iterate migration versions: %w", err)
+	}
+
+	for _, version := range versions {
+		content, ok := migrationsDownSQL[version]
+		if !ok {
+			return 0, fmt.Errorf("migration %d has no down migration", version)
+		}
+
+		db.logger.Info("rolling back migration",
+			slog.Int("version", version),
+		)
+
+		if _, err := tx.ExecContext(ctx, content); err != nil {
+			return 0, fmt.Errorf("roll back migration %d: %w", version, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations WHERE version = ?", version); err != nil {
+			return 0, fmt.Errorf("unrecord migration %d: %w", version, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit rollback: %w", err)
+	}
+
+	db.logger.Info("rollback complete",
+		slog.Int("rolled_back", len(versions)),
+	)
+
+	return len(versions), nil
+}
+
 // =============================================================================
 // Transaction Helpers
 // =============================================================================
@@ -280,6 +468,9 @@ var ErrNotFound = errors.New("record not found")
 // ErrDuplicate is returned when a unique constraint is violated.
 var ErrDuplicate = errors.New("duplicate record")
 
+// ErrKeyExpired is returned when an API key exists but is past its expiry.
+var ErrKeyExpired = errors.New("api key expired")
+
 // IsNotFound checks if an error is a "not found" error.
 func IsNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound) || errors.Is(err, sql.ErrNoRows)
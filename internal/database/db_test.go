@@ -3,8 +3,12 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 )
@@ -21,7 +25,7 @@ import (
 // - Isolated: Each test gets a fresh database
 // - Clean: Automatically destroyed when test ends
 // - No cleanup needed: No leftover files
-func setupTestDB(t *testing.T) (*DB, func()) {
+func setupTestDB(t testing.TB) (*DB, func()) {
 	t.Helper()
 
 	// Create a logger that only shows errors during tests
@@ -102,6 +106,145 @@ func TestHealth_AfterClose(t *testing.T) {
 	}
 }
 
+func TestJournalMode_WAL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal.db")
+	db, err := Open(DefaultConfig(path), nil)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer db.Close()
+
+	if db.Path() != path {
+		t.Errorf("Path() = %q, want %q", db.Path(), path)
+	}
+	mode, err := db.JournalMode(context.Background())
+	if err != nil {
+		t.Fatalf("JournalMode: %v", err)
+	}
+	if mode != "wal" {
+		t.Errorf("JournalMode() = %q, want %q", mode, "wal")
+	}
+}
+
+func TestGo_CloseWaits(t *testing.T) {
+	db, _ := setupTestDB(t)
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	started := make(chan struct{})
+	var queryErr error
+	if !db.Go(func() {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+		queryErr = db.Health(ctx)
+	}) {
+		t.Fatal("Go before Close = false, want true")
+	}
+	<-started
+
+	if err := db.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	// Close returned only after the work ran against the open database
+	if queryErr != nil {
+		t.Errorf("background query: %v, want it to run before Close", queryErr)
+	}
+
+	if db.Go(func() { t.Error("work started after Close") }) {
+		t.Error("Go after Close = true, want false")
+	}
+}
+
+func TestBackup_Success(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	reading := &DailyReading{Date: "2025-01-01", FirstReading: "Genesis 1:1-5"}
+	if err := db.UpsertDailyReading(ctx, reading); err != nil {
+		t.Fatalf("upsert failed: %v", err)
+	}
+
+	backupPath := filepath.Join(t.TempDir(), "backup.db")
+	if err := db.Backup(ctx, backupPath); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+
+	backup, err := Open(DefaultConfig(backupPath), nil)
+	if err != nil {
+		t.Fatalf("open backup failed: %v", err)
+	}
+	defer backup.Close()
+
+	got, err := backup.GetReadingByDate(ctx, "2025-01-01")
+	if err != nil {
+		t.Fatalf("backup missing reading: %v", err)
+	}
+	if got.FirstReading != "Genesis 1:1-5" {
+		t.Errorf("FirstReading = %q, want %q", got.FirstReading, "Genesis 1:1-5")
+	}
+
+	// A second backup to the same path must not overwrite the first
+	if err := db.Backup(ctx, backupPath); err == nil {
+		t.Error("expected error when backup destination exists")
+	}
+}
+
+func TestSnapshot_ReadingsOnly(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	reading := yearOfReadings(1)[0]
+	if err := db.UpsertDailyReading(ctx, &reading); err != nil {
+		t.Fatalf("seed reading: %v", err)
+	}
+	user, err := db.CreateUser(ctx, "reader", nil, nil)
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	if _, err := db.CreateAPIKey(ctx, user.ID, "Phone", 0); err != nil {
+		t.Fatalf("create api key: %v", err)
+	}
+	notes := "private"
+	db.CreateProgress(ctx, &ReadingProgress{UserID: "1", ReadingDate: reading.Date, Notes: &notes, CompletedAt: time.Now()})
+	db.CreateReadingPlan(ctx, &ReadingPlan{UserID: "1", StartDate: reading.Date, LengthDays: 30})
+
+	snapshotPath := filepath.Join(t.TempDir(), "snapshot.db")
+	if err := db.Snapshot(ctx, snapshotPath); err != nil {
+		t.Fatalf("Snapshot: %v", err)
+	}
+
+	snapshot, err := Open(DefaultConfig(snapshotPath), nil)
+	if err != nil {
+		t.Fatalf("open snapshot: %v", err)
+	}
+	defer snapshot.Close()
+
+	if _, err := snapshot.GetReadingByDate(ctx, reading.Date); err != nil {
+		t.Errorf("snapshot missing reading: %v", err)
+	}
+	for _, table := range privateTables {
+		var count int
+		if err := snapshot.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table).Scan(&count); err != nil {
+			t.Fatalf("count %s: %v", table, err)
+		}
+		if count != 0 {
+			t.Errorf("snapshot %s has %d rows, want 0", table, count)
+		}
+	}
+
+	// The source database is untouched
+	if _, err := db.GetUserByUsername(ctx, "reader"); err != nil {
+		t.Errorf("source lost user: %v", err)
+	}
+}
+
 // =============================================================================
 // MIGRATION TESTS
 // =============================================================================
@@ -118,9 +261,9 @@ func TestMigrate_FreshDatabase(t *testing.T) {
 		t.Fatalf("migration failed: %v", err)
 	}
 
-	// Should apply all 3 migrations
-	if count != 3 {
-		t.Errorf("applied %d migrations, want 3", count)
+	// Should apply all migrations
+	if count != len(migrationsSQL) {
+		t.Errorf("applied %d migrations, want %d", count, len(migrationsSQL))
 	}
 
 	// Verify schema_migrations table exists and has correct entries
@@ -130,8 +273,8 @@ func TestMigrate_FreshDatabase(t *testing.T) {
 		t.Fatalf("failed to query migrations: %v", err)
 	}
 
-	if migrationCount != 3 {
-		t.Errorf("schema_migrations has %d entries, want 3", migrationCount)
+	if migrationCount != len(migrationsSQL) {
+		t.Errorf("schema_migrations has %d entries, want %d", migrationCount, len(migrationsSQL))
 	}
 }
 
@@ -153,8 +296,8 @@ func TestMigrate_Idempotent(t *testing.T) {
 	}
 
 	// First run should apply all migrations
-	if count1 != 3 {
-		t.Errorf("first run applied %d migrations, want 3", count1)
+	if count1 != len(migrationsSQL) {
+		t.Errorf("first run applied %d migrations, want %d", count1, len(migrationsSQL))
 	}
 
 	// Second run should apply zero migrations
@@ -182,6 +325,7 @@ func TestMigrate_CreatesAllTables(t *testing.T) {
 		"reading_progress",
 		"users",
 		"api_keys",
+		"readings_fts",
 	}
 
 	for _, table := range expectedTables {
@@ -199,6 +343,82 @@ func TestMigrate_CreatesAllTables(t *testing.T) {
 	}
 }
 
+func TestRollback(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := db.Migrate(ctx); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+
+	tableExists := func(name string) bool {
+		var count int
+		err := db.QueryRowContext(ctx,
+			"SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name=?", name,
+		).Scan(&count)
+		if err != nil {
+			t.Fatalf("failed to check for table %s: %v", name, err)
+		}
+		return count == 1
+	}
+
+	// Roll back migration 2 and everything after it
+	count, err := db.Rollback(ctx, 1)
+	if err != nil {
+		t.Fatalf("rollback failed: %v", err)
+	}
+	if count != len(migrationsSQL)-1 {
+		t.Errorf("rolled back %d migrations, want %d", count, len(migrationsSQL)-1)
+	}
+
+	for _, table := range []string{"reading_progress", "users", "api_keys", "readings_fts", "reading_plans"} {
+		if tableExists(table) {
+			t.Errorf("table %s still exists after rollback", table)
+		}
+	}
+	if !tableExists("daily_readings") {
+		t.Error("baseline table daily_readings was dropped")
+	}
+
+	var versions int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM schema_migrations").Scan(&versions); err != nil {
+		t.Fatalf("failed to query migrations: %v", err)
+	}
+	if versions != 1 {
+		t.Errorf("schema_migrations has %d entries, want 1", versions)
+	}
+
+	// Rolled-back migrations apply again
+	count, err = db.Migrate(ctx)
+	if err != nil {
+		t.Fatalf("re-migration failed: %v", err)
+	}
+	if count != len(migrationsSQL)-1 || !tableExists("reading_progress") {
+		t.Errorf("re-migration applied %d migrations, want %d", count, len(migrationsSQL)-1)
+	}
+}
+
+func TestRollback_BelowBaseline(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	if _, err := db.Rollback(ctx, 0); err == nil {
+		t.Error("expected error rolling back below the baseline")
+	}
+}
+
+func TestMigrationsDownSQL_Complete(t *testing.T) {
+	for version := range migrationsSQL {
+		if _, ok := migrationsDownSQL[version]; !ok && version != baselineMigration {
+			t.Errorf("migration %d has no down migration", version)
+		}
+	}
+}
+
 // =============================================================================
 // DAILY READINGS CRUD TESTS
 // =============================================================================
@@ -216,6 +436,204 @@ func TestGetReadingByDate_NotFound(t *testing.T) {
 	}
 }
 
+func TestGetReadingByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	if err := db.UpsertDailyReading(ctx, &DailyReading{Date: "2025-01-01", GospelReading: "John 1:1-14"}); err != nil {
+		t.Fatalf("upsert failed: %v", err)
+	}
+	byDate, err := db.GetReadingByDate(ctx, "2025-01-01")
+	if err != nil {
+		t.Fatalf("get by date failed: %v", err)
+	}
+
+	byID, err := db.GetReadingByID(ctx, byDate.ID)
+	if err != nil {
+		t.Fatalf("get by id failed: %v", err)
+	}
+	if byID.Date != "2025-01-01" || byID.GospelReading != "John 1:1-14" {
+		t.Errorf("got %s / %q, want 2025-01-01 / John 1:1-14", byID.Date, byID.GospelReading)
+	}
+
+	if _, err := db.GetReadingByID(ctx, byDate.ID+1); !IsNotFound(err) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+// yearOfReadings returns n consecutive daily readings from 2025-01-01.
+func yearOfReadings(n int) []DailyReading {
+	readings := make([]DailyReading, n)
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for i := range readings {
+		readings[i] = DailyReading{
+			Date:          start.AddDate(0, 0, i).Format("2006-01-02"),
+			MorningPsalms: []string{"98", "147:1-11"},
+			EveningPsalms: []string{"99", "8"},
+			FirstReading:  "Genesis 17:1-12a, 15-16",
+			SecondReading: "Colossians 2:6-12",
+			GospelReading: "John 16:23b-30",
+		}
+	}
+	return readings
+}
+
+func TestUpsertDailyReadings_Batch(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	// Spans several chunks
+	readings := yearOfReadings(250)
+
+	upsert := func() (inserted, updated int) {
+		err := db.WithTx(ctx, func(tx *Tx) error {
+			var err error
+			inserted, updated, err = tx.UpsertDailyReadings(ctx, readings)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("batch upsert failed: %v", err)
+		}
+		return inserted, updated
+	}
+
+	if inserted, updated := upsert(); inserted != 250 || updated != 0 {
+		t.Errorf("first batch: inserted %d, updated %d, want 250, 0", inserted, updated)
+	}
+
+	readings[200].GospelReading = "Mark 1:1-8"
+	readings = append(readings, yearOfReadings(251)[250])
+	if inserted, updated := upsert(); inserted != 1 || updated != 250 {
+		t.Errorf("second batch: inserted %d, updated %d, want 1, 250", inserted, updated)
+	}
+
+	stats, err := db.GetReadingStats(ctx)
+	if err != nil {
+		t.Fatalf("stats failed: %v", err)
+	}
+	if stats.TotalDays != 251 {
+		t.Errorf("TotalDays = %d, want 251", stats.TotalDays)
+	}
+
+	reading, err := db.GetReadingByDate(ctx, readings[200].Date)
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if reading.GospelReading != "Mark 1:1-8" || len(reading.EveningPsalms) != 2 {
+		t.Errorf("reading = %+v, want updated gospel and both evening psalms", reading)
+	}
+}
+
+func TestSearchReadingsByReference(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	seed := []DailyReading{
+		{Date: "2025-01-01", FirstReading: "Isaiah 40:1-11", SecondReading: "1 John 4:7-21", GospelReading: "Luke 3:1-6"},
+		{Date: "2025-01-02", FirstReading: "Isaiah 41:1-10", SecondReading: "Romans 8:1-11", GospelReading: "John 1:1-14"},
+		{Date: "2025-01-03", FirstReading: "Genesis 1:1-5", SecondReading: "Acts 2:1-4", GospelReading: "Mark 1:1-8"},
+	}
+	for i := range seed {
+		if err := db.UpsertDailyReading(ctx, &seed[i]); err != nil {
+			t.Fatalf("upsert failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  []string // date/reading_type of each match
+	}{
+		{"Isaiah", []string{"2025-01-01/first", "2025-01-02/first"}},
+		{"Isaiah 40", []string{"2025-01-01/first"}},
+		{"isa 40", nil}, // Words must match whole
+		{"John", []string{"2025-01-01/second", "2025-01-02/gospel"}},
+		{"1 John", []string{"2025-01-01/second"}},
+		{"40 Isaiah", nil},
+		{`"; DROP`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			matches, err := db.SearchReadingsByReference(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("search failed: %v", err)
+			}
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Date+"/"+string(m.ReadingType))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Updates are reindexed
+	seed[2].FirstReading = "Isaiah 40:12-17"
+	if err := db.UpsertDailyReading(ctx, &seed[2]); err != nil {
+		t.Fatalf("upsert failed: %v", err)
+	}
+	matches, err := db.SearchReadingsByReference(ctx, "Isaiah 40")
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if len(matches) != 2 || matches[1].Date != "2025-01-03" {
+		t.Errorf("after update: matches = %v, want 2025-01-01 and 2025-01-03", matches)
+	}
+	if matches, _ := db.SearchReadingsByReference(ctx, "Genesis"); len(matches) != 0 {
+		t.Errorf("replaced reference still matches: %v", matches)
+	}
+}
+
+func TestCanticleReading(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	reading := &DailyReading{
+		Date:          "2025-12-21",
+		FirstReading:  "Micah 5:2-5a",
+		SecondReading: "Hebrews 10:5-10",
+		GospelReading: "Luke 1:39-45",
+		Canticle:      "Luke 1:46-55",
+	}
+	if err := db.UpsertDailyReading(ctx, reading); err != nil {
+		t.Fatalf("upsert failed: %v", err)
+	}
+
+	got, err := db.GetReadingByDate(ctx, "2025-12-21")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if got.Canticle != "Luke 1:46-55" || got.Reference(ReadingTypeCanticle) != "Luke 1:46-55" {
+		t.Errorf("Canticle = %q, want %q", got.Canticle, "Luke 1:46-55")
+	}
+
+	// The canticle sorts after the gospel
+	matches, err := db.GetReadingsByBook(ctx, "Luke")
+	if err != nil {
+		t.Fatalf("get by book failed: %v", err)
+	}
+	if len(matches) != 2 || matches[0].ReadingType != ReadingTypeGospel || matches[1].ReadingType != ReadingTypeCanticle {
+		t.Errorf("matches = %v, want gospel then canticle", matches)
+	}
+
+	if !ReadingTypeCanticle.Optional() || ReadingTypeGospel.Optional() {
+		t.Error("only the canticle should be optional")
+	}
+}
+
 func TestUpsertDailyReading_Insert(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -349,6 +767,41 @@ func TestGetReadingsByDateRange(t *testing.T) {
 	}
 }
 
+func TestGetReadingsByDates(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	for _, date := range []string{"2025-01-01", "2025-01-05", "2025-01-12"} {
+		reading := yearOfReadings(1)[0]
+		reading.Date = date
+		if err := db.UpsertDailyReading(ctx, &reading); err != nil {
+			t.Fatalf("seed %s: %v", date, err)
+		}
+	}
+
+	readings, err := db.GetReadingsByDates(ctx, []string{"2025-01-12", "2025-01-02", "2025-01-01"})
+	if err != nil {
+		t.Fatalf("GetReadingsByDates: %v", err)
+	}
+
+	// Date order, missing dates skipped
+	var got []string
+	for _, r := range readings {
+		got = append(got, r.Date)
+	}
+	if want := []string{"2025-01-01", "2025-01-12"}; !slices.Equal(got, want) {
+		t.Errorf("dates = %v, want %v", got, want)
+	}
+
+	readings, err = db.GetReadingsByDates(ctx, nil)
+	if err != nil || len(readings) != 0 {
+		t.Errorf("no dates: got %v, %v, want empty", readings, err)
+	}
+}
+
 func TestDeleteDailyReading_Success(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -625,7 +1078,7 @@ func TestCreateAPIKey_Success(t *testing.T) {
 	user, _ := db.CreateUser(ctx, "testuser", &email, nil)
 
 	// Create API key
-	keyWithPlaintext, err := db.CreateAPIKey(ctx, user.ID, "Test Device")
+	keyWithPlaintext, err := db.CreateAPIKey(ctx, user.ID, "Test Device", 0)
 	if err != nil {
 		t.Fatalf("create api key failed: %v", err)
 	}
@@ -659,7 +1112,7 @@ func TestValidateAPIKey_Success(t *testing.T) {
 	// Create user and key
 	email := "test@example.com"
 	user, _ := db.CreateUser(ctx, "testuser", &email, nil)
-	keyWithPlaintext, _ := db.CreateAPIKey(ctx, user.ID, "Test Device")
+	keyWithPlaintext, _ := db.CreateAPIKey(ctx, user.ID, "Test Device", 0)
 
 	// Validate the key
 	validatedUser, err := db.ValidateAPIKey(ctx, keyWithPlaintext.PlaintextKey)
@@ -696,7 +1149,7 @@ func TestValidateAPIKey_InactiveUser(t *testing.T) {
 	// Create user and key
 	email := "test@example.com"
 	user, _ := db.CreateUser(ctx, "testuser", &email, nil)
-	keyWithPlaintext, _ := db.CreateAPIKey(ctx, user.ID, "Test Device")
+	keyWithPlaintext, _ := db.CreateAPIKey(ctx, user.ID, "Test Device", 0)
 
 	// Deactivate user
 	_, err := db.ExecContext(ctx, "UPDATE users SET active = 0 WHERE id = ?", user.ID)
@@ -723,9 +1176,9 @@ func TestListUserAPIKeys(t *testing.T) {
 	user, _ := db.CreateUser(ctx, "testuser", &email, nil)
 
 	// Create multiple keys
-	db.CreateAPIKey(ctx, user.ID, "Device 1")
-	db.CreateAPIKey(ctx, user.ID, "Device 2")
-	db.CreateAPIKey(ctx, user.ID, "Device 3")
+	db.CreateAPIKey(ctx, user.ID, "Device 1", 0)
+	db.CreateAPIKey(ctx, user.ID, "Device 2", 0)
+	db.CreateAPIKey(ctx, user.ID, "Device 3", 0)
 
 	// List keys
 	keys, err := db.ListUserAPIKeys(ctx, user.ID)
@@ -738,6 +1191,108 @@ func TestListUserAPIKeys(t *testing.T) {
 	}
 }
 
+func TestValidateAPIKey_Expiry(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	user, _ := db.CreateUser(ctx, "testuser", nil, nil)
+
+	never, err := db.CreateAPIKey(ctx, user.ID, "Never Expires", 0)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	active, err := db.CreateAPIKey(ctx, user.ID, "Active", time.Hour)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	expired, err := db.CreateAPIKey(ctx, user.ID, "Expired", time.Hour)
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `UPDATE api_keys SET expires_at = datetime('now', '-1 minute') WHERE id = ?`, expired.ID); err != nil {
+		t.Fatalf("backdate expiry: %v", err)
+	}
+
+	if never.ExpiresAt != nil {
+		t.Errorf("never-expiring key ExpiresAt = %v, want nil", never.ExpiresAt)
+	}
+	if active.ExpiresAt == nil || time.Until(*active.ExpiresAt) <= 0 {
+		t.Errorf("active key ExpiresAt = %v, want about an hour from now", active.ExpiresAt)
+	}
+
+	if _, err := db.ValidateAPIKey(ctx, never.PlaintextKey); err != nil {
+		t.Errorf("never-expiring key: %v", err)
+	}
+	if _, err := db.ValidateAPIKey(ctx, active.PlaintextKey); err != nil {
+		t.Errorf("active key: %v", err)
+	}
+	if _, err := db.ValidateAPIKey(ctx, expired.PlaintextKey); !errors.Is(err, ErrKeyExpired) {
+		t.Errorf("expired key: err = %v, want ErrKeyExpired", err)
+	}
+
+	// Expired keys are still listed, marked as such
+	keys, err := db.ListUserAPIKeys(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("list keys: %v", err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("got %d keys, want 3", len(keys))
+	}
+	for _, k := range keys {
+		if want := k.ID == expired.ID; k.Expired != want {
+			t.Errorf("key %q Expired = %v, want %v", k.Name, k.Expired, want)
+		}
+	}
+}
+
+func TestTouchAPIKey(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	user, _ := db.CreateUser(ctx, "testuser", nil, nil)
+	used, _ := db.CreateAPIKey(ctx, user.ID, "Used Device", 0)
+	db.CreateAPIKey(ctx, user.ID, "Idle Device", 0)
+
+	if err := db.TouchAPIKey(ctx, used.PlaintextKey); err != nil {
+		t.Fatalf("touch api key: %v", err)
+	}
+
+	users, err := db.ListUsersWithKeys(ctx)
+	if err != nil {
+		t.Fatalf("list users with keys: %v", err)
+	}
+
+	var found *UserWithKeys
+	for i := range users {
+		if users[i].ID == user.ID {
+			found = &users[i]
+		}
+	}
+	if found == nil {
+		t.Fatalf("user %d not listed", user.ID)
+	}
+	if found.LastLoginAt == nil {
+		t.Error("LastLoginAt should be set after the key is used")
+	}
+	if len(found.APIKeys) != 2 {
+		t.Fatalf("got %d keys, want 2", len(found.APIKeys))
+	}
+	for _, k := range found.APIKeys {
+		switch {
+		case k.ID == used.ID && k.LastUsedAt == nil:
+			t.Error("used key should have LastUsedAt set")
+		case k.ID != used.ID && k.LastUsedAt != nil:
+			t.Error("idle key should have nil LastUsedAt")
+		}
+	}
+}
+
 func TestRevokeAPIKey_Success(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -748,7 +1303,7 @@ func TestRevokeAPIKey_Success(t *testing.T) {
 	// Create user and key
 	email := "test@example.com"
 	user, _ := db.CreateUser(ctx, "testuser", &email, nil)
-	keyWithPlaintext, _ := db.CreateAPIKey(ctx, user.ID, "Test Device")
+	keyWithPlaintext, _ := db.CreateAPIKey(ctx, user.ID, "Test Device", 0)
 
 	// Revoke the key
 	err := db.RevokeAPIKey(ctx, keyWithPlaintext.ID, user.ID)
@@ -875,6 +1430,132 @@ func TestCreateProgress_Duplicate(t *testing.T) {
 	}
 }
 
+func TestMarkDayComplete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	db.CreateUser(ctx, "testuser", nil, nil)
+	reading := yearOfReadings(1)[0]
+	if err := db.UpsertDailyReading(ctx, &reading); err != nil {
+		t.Fatalf("seed reading: %v", err)
+	}
+
+	notes := "First"
+	inserted, err := db.MarkDayComplete(ctx, &ReadingProgress{UserID: "1", ReadingDate: "2025-01-01", Notes: &notes, CompletedAt: time.Now()})
+	if err != nil || !inserted {
+		t.Fatalf("first mark = %v, %v, want true, nil", inserted, err)
+	}
+
+	// Repeating is a no-op that keeps the original entry
+	other := "Second"
+	inserted, err = db.MarkDayComplete(ctx, &ReadingProgress{UserID: "1", ReadingDate: "2025-01-01", Notes: &other, CompletedAt: time.Now()})
+	if err != nil || inserted {
+		t.Fatalf("second mark = %v, %v, want false, nil", inserted, err)
+	}
+
+	progress, err := db.GetProgressByDate(ctx, "1", "2025-01-01")
+	if err != nil {
+		t.Fatalf("get progress: %v", err)
+	}
+	if progress.Notes == nil || *progress.Notes != "First" {
+		t.Errorf("Notes = %v, want %q", progress.Notes, "First")
+	}
+}
+
+func TestReadingPlanProgress(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	for _, reading := range yearOfReadings(10) {
+		if err := db.UpsertDailyReading(ctx, &reading); err != nil {
+			t.Fatalf("seed reading: %v", err)
+		}
+	}
+
+	plan := &ReadingPlan{UserID: "1", Name: "Four days", StartDate: "2025-01-03", LengthDays: 4}
+	if err := db.CreateReadingPlan(ctx, plan); err != nil {
+		t.Fatalf("CreateReadingPlan: %v", err)
+	}
+	if plan.ID == 0 {
+		t.Fatal("plan ID not set")
+	}
+
+	// Inside the plan: Jan 3 and 5. Outside: Jan 1 and 7.
+	for _, date := range []string{"2025-01-01", "2025-01-03", "2025-01-05", "2025-01-07"} {
+		if err := db.CreateProgress(ctx, &ReadingProgress{UserID: "1", ReadingDate: date, CompletedAt: time.Now()}); err != nil {
+			t.Fatalf("CreateProgress(%s): %v", date, err)
+		}
+	}
+	// Another user's progress doesn't count
+	db.CreateProgress(ctx, &ReadingProgress{UserID: "2", ReadingDate: "2025-01-04", CompletedAt: time.Now()})
+
+	got, err := db.GetReadingPlan(ctx, "1", plan.ID)
+	if err != nil {
+		t.Fatalf("GetReadingPlan: %v", err)
+	}
+	progress, err := db.GetPlanProgress(ctx, got)
+	if err != nil {
+		t.Fatalf("GetPlanProgress: %v", err)
+	}
+	if progress.EndDate != "2025-01-06" {
+		t.Errorf("EndDate = %q, want %q", progress.EndDate, "2025-01-06")
+	}
+	if progress.CompletedDays != 2 {
+		t.Errorf("CompletedDays = %d, want 2", progress.CompletedDays)
+	}
+	if progress.CompletionPercent != 50 {
+		t.Errorf("CompletionPercent = %v, want 50", progress.CompletionPercent)
+	}
+
+	if _, err := db.GetReadingPlan(ctx, "2", plan.ID); !IsNotFound(err) {
+		t.Errorf("other user's plan: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestUpdateProgressNotes(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	db.CreateUser(ctx, "testuser", nil, nil)
+	reading := yearOfReadings(1)[0]
+	if err := db.UpsertDailyReading(ctx, &reading); err != nil {
+		t.Fatalf("seed reading: %v", err)
+	}
+	notes := "Original"
+	if err := db.CreateProgress(ctx, &ReadingProgress{UserID: "1", ReadingDate: "2025-01-01", Notes: &notes, CompletedAt: time.Now()}); err != nil {
+		t.Fatalf("create progress: %v", err)
+	}
+
+	updated := "Updated"
+	if err := db.UpdateProgressNotes(ctx, "1", "2025-01-01", &updated); err != nil {
+		t.Fatalf("update notes: %v", err)
+	}
+	if p, _ := db.GetProgressByDate(ctx, "1", "2025-01-01"); p.Notes == nil || *p.Notes != "Updated" {
+		t.Errorf("Notes = %v, want %q", p.Notes, "Updated")
+	}
+
+	empty := ""
+	if err := db.UpdateProgressNotes(ctx, "1", "2025-01-01", &empty); err != nil {
+		t.Fatalf("clear notes: %v", err)
+	}
+	if p, _ := db.GetProgressByDate(ctx, "1", "2025-01-01"); p.Notes != nil {
+		t.Errorf("Notes = %q, want nil after clearing", *p.Notes)
+	}
+
+	if err := db.UpdateProgressNotes(ctx, "2", "2025-01-01", &updated); !IsNotFound(err) {
+		t.Errorf("other user's entry: err = %v, want ErrNotFound", err)
+	}
+}
+
 func TestDeleteProgress_Success(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -943,6 +1624,113 @@ func TestGetProgressStats_Empty(t *testing.T) {
 	}
 }
 
+func TestStreaks(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	days := func(dates ...string) []time.Time {
+		out := make([]time.Time, len(dates))
+		for i, d := range dates {
+			out[i] = day(d)
+		}
+		return out
+	}
+	today := day("2025-03-10")
+
+	tests := []struct {
+		name        string
+		days        []time.Time
+		wantCurrent int
+		wantLongest int
+	}{
+		{"none", nil, 0, 0},
+		{"single day today", days("2025-03-10"), 1, 1},
+		{
+			name:        "long past streak, short current one",
+			days:        days("2025-01-01", "2025-01-02", "2025-01-03", "2025-01-04", "2025-01-05", "2025-03-09", "2025-03-10"),
+			wantCurrent: 2,
+			wantLongest: 5,
+		},
+		{
+			name:        "current streak ending yesterday",
+			days:        days("2025-03-07", "2025-03-08", "2025-03-09"),
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name:        "lapsed streak",
+			days:        days("2025-03-01", "2025-03-02", "2025-03-03", "2025-03-08"),
+			wantCurrent: 0,
+			wantLongest: 3,
+		},
+		{
+			name:        "across a month boundary",
+			days:        days("2025-02-27", "2025-02-28", "2025-03-01"),
+			wantCurrent: 0,
+			wantLongest: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := streaks(tt.days, today)
+			if current != tt.wantCurrent || longest != tt.wantLongest {
+				t.Errorf("streaks() = %d, %d, want %d, %d", current, longest, tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
+
+func TestGetProgressStats_Streaks(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	db.Migrate(ctx)
+
+	db.CreateUser(ctx, "testuser", nil, nil)
+	userID := "1"
+
+	// A six-day streak long ago, then yesterday and today
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	var dates []string
+	for i := 0; i < 6; i++ {
+		dates = append(dates, today.AddDate(0, 0, -30+i).Format("2006-01-02"))
+	}
+	dates = append(dates, today.AddDate(0, 0, -1).Format("2006-01-02"), today.Format("2006-01-02"))
+
+	for _, date := range dates {
+		reading := &DailyReading{
+			Date:          date,
+			MorningPsalms: []string{"1"},
+			EveningPsalms: []string{"2"},
+			FirstReading:  "Genesis 1:1",
+			SecondReading: "Romans 1:1",
+			GospelReading: "John 1:1",
+		}
+		if err := db.UpsertDailyReading(ctx, reading); err != nil {
+			t.Fatalf("seed reading %s: %v", date, err)
+		}
+		if err := db.CreateProgress(ctx, &ReadingProgress{UserID: userID, ReadingDate: date, CompletedAt: now}); err != nil {
+			t.Fatalf("create progress %s: %v", date, err)
+		}
+	}
+
+	stats, err := db.GetProgressStats(ctx, userID)
+	if err != nil {
+		t.Fatalf("get stats failed: %v", err)
+	}
+
+	if stats.CurrentStreak != 2 {
+		t.Errorf("CurrentStreak = %d, want 2", stats.CurrentStreak)
+	}
+	if stats.LongestStreak != 6 {
+		t.Errorf("LongestStreak = %d, want 6", stats.LongestStreak)
+	}
+}
+
 // =============================================================================
 // SCRAPE LOG TESTS
 // =============================================================================
@@ -1206,3 +1994,89 @@ func TestReadingProgress_UniqueUserDate(t *testing.T) {
 		t.Errorf("expected ErrDuplicate, got %v", err)
 	}
 }
+
+// =============================================================================
+// BENCHMARKS
+// =============================================================================
+
+// BenchmarkGetReadingByDate looks up every date of a seeded year in turn.
+// Run with: go test -bench GetReadingByDate ./internal/database
+func BenchmarkGetReadingByDate(b *testing.B) {
+	db, cleanup := setupTestDB(b)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := db.Migrate(ctx); err != nil {
+		b.Fatalf("Migrate() error = %v", err)
+	}
+
+	var dates []string
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for d := start; d.Year() == 2025; d = d.AddDate(0, 0, 1) {
+		date := d.Format("2006-01-02")
+		reading := &DailyReading{
+			Date:          date,
+			MorningPsalms: []string{"98", "147:1-11"},
+			EveningPsalms: []string{"99", "8"},
+			FirstReading:  "Genesis 17:1-12a, 15-16",
+			SecondReading: "Colossians 2:6-12",
+			GospelReading: "John 16:23b-30",
+		}
+		if err := db.UpsertDailyReading(ctx, reading); err != nil {
+			b.Fatalf("seed %s: %v", date, err)
+		}
+		dates = append(dates, date)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := db.GetReadingByDate(ctx, dates[i%len(dates)]); err != nil {
+			b.Fatalf("GetReadingByDate() error = %v", err)
+		}
+	}
+}
+
+// BenchmarkUpsertDailyReadings_Batch and BenchmarkUpsertDailyReadings_PerRow
+// compare a batched import of a year's readings against one
+// UpsertDailyReading call per day.
+func BenchmarkUpsertDailyReadings_Batch(b *testing.B) {
+	db, cleanup := setupTestDB(b)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := db.Migrate(ctx); err != nil {
+		b.Fatalf("Migrate() error = %v", err)
+	}
+	readings := yearOfReadings(365)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		err := db.WithTx(ctx, func(tx *Tx) error {
+			_, _, err := tx.UpsertDailyReadings(ctx, readings)
+			return err
+		})
+		if err != nil {
+			b.Fatalf("UpsertDailyReadings() error = %v", err)
+		}
+	}
+}
+
+func BenchmarkUpsertDailyReadings_PerRow(b *testing.B) {
+	db, cleanup := setupTestDB(b)
+	defer cleanup()
+
+	ctx := context.Background()
+	if _, err := db.Migrate(ctx); err != nil {
+		b.Fatalf("Migrate() error = %v", err)
+	}
+	readings := yearOfReadings(365)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range readings {
+			if err := db.UpsertDailyReading(ctx, &readings[j]); err != nil {
+				b.Fatalf("UpsertDailyReading() error = %v", err)
+			}
+		}
+	}
+}
@@ -185,10 +185,166 @@ VALUES ('admin', 'admin@yourdomain.com', 'Admin User', 1);
 -- VALUES (1, 'YOUR_HASH_HERE', 'Admin Master Key', 1);
 `
 
+// migrationV4ReadingSearch adds a full-text index over the scripture
+// references, kept in sync with daily_readings by triggers.
+const migrationV4ReadingSearch = `
+-- ============================================================================
+-- Migration 004: Full-Text Search of References
+-- ============================================================================
+-- External-content FTS4 table: the text lives in daily_readings and
+-- readings_fts.docid is daily_readings.id
+CREATE VIRTUAL TABLE IF NOT EXISTS readings_fts USING fts4(
+    content="daily_readings",
+    first_reading,
+    second_reading,
+    gospel_reading
+);
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_before_update
+    BEFORE UPDATE ON daily_readings BEGIN
+    DELETE FROM readings_fts WHERE docid = old.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_before_delete
+    BEFORE DELETE ON daily_readings BEGIN
+    DELETE FROM readings_fts WHERE docid = old.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_after_update
+    AFTER UPDATE ON daily_readings BEGIN
+    INSERT INTO readings_fts (docid, first_reading, second_reading, gospel_reading)
+    VALUES (new.id, new.first_reading, new.second_reading, new.gospel_reading);
+END;
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_after_insert
+    AFTER INSERT ON daily_readings BEGIN
+    INSERT INTO readings_fts (docid, first_reading, second_reading, gospel_reading)
+    VALUES (new.id, new.first_reading, new.second_reading, new.gospel_reading);
+END;
+
+-- Index readings imported before this migration
+INSERT INTO readings_fts (readings_fts) VALUES ('rebuild');
+`
+
+// readingsFTSv5 is the search index and its sync triggers from migration 5
+// on: migration 4's index plus the canticle column.
+const readingsFTSv5 = `
+CREATE VIRTUAL TABLE IF NOT EXISTS readings_fts USING fts4(
+    content="daily_readings",
+    first_reading,
+    second_reading,
+    gospel_reading,
+    canticle
+);
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_before_update
+    BEFORE UPDATE ON daily_readings BEGIN
+    DELETE FROM readings_fts WHERE docid = old.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_before_delete
+    BEFORE DELETE ON daily_readings BEGIN
+    DELETE FROM readings_fts WHERE docid = old.id;
+END;
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_after_update
+    AFTER UPDATE ON daily_readings BEGIN
+    INSERT INTO readings_fts (docid, first_reading, second_reading, gospel_reading, canticle)
+    VALUES (new.id, new.first_reading, new.second_reading, new.gospel_reading, new.canticle);
+END;
+
+CREATE TRIGGER IF NOT EXISTS daily_readings_fts_after_insert
+    AFTER INSERT ON daily_readings BEGIN
+    INSERT INTO readings_fts (docid, first_reading, second_reading, gospel_reading, canticle)
+    VALUES (new.id, new.first_reading, new.second_reading, new.gospel_reading, new.canticle);
+END;
+
+INSERT INTO readings_fts (readings_fts) VALUES ('rebuild');
+`
+
+// dropReadingsFTS removes the search index and its sync triggers.
+const dropReadingsFTS = `
+DROP TRIGGER IF EXISTS daily_readings_fts_before_update;
+DROP TRIGGER IF EXISTS daily_readings_fts_before_delete;
+DROP TRIGGER IF EXISTS daily_readings_fts_after_update;
+DROP TRIGGER IF EXISTS daily_readings_fts_after_insert;
+DROP TABLE IF EXISTS readings_fts;
+`
+
+// migrationV5Canticle adds an optional canticle reading, read after the
+// gospel by offices that appoint one, and indexes it for search.
+const migrationV5Canticle = `
+-- ============================================================================
+-- Migration 005: Canticle Reading
+-- ============================================================================
+ALTER TABLE daily_readings ADD COLUMN canticle TEXT NOT NULL DEFAULT '';
+
+-- FTS4 tables can't gain columns; rebuild the index with canticle
+` + dropReadingsFTS + readingsFTSv5
+
+// migrationV6APIKeyExpiry adds an optional expiry time to API keys.
+// Keys without one never expire.
+const migrationV6APIKeyExpiry = `
+-- ============================================================================
+-- Migration 006: API Key Expiry
+-- ============================================================================
+ALTER TABLE api_keys ADD COLUMN expires_at TEXT;
+`
+
+// migrationV7ReadingPlans adds reading plans: a user's goal to read every
+// day for a number of days from a start date. Completion is computed from
+// reading_progress, so plans store no progress of their own.
+const migrationV7ReadingPlans = `
+-- ============================================================================
+-- Migration 007: Reading Plans
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS reading_plans (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    start_date TEXT NOT NULL,
+    length_days INTEGER NOT NULL CHECK (length_days > 0),
+    created_at TEXT NOT NULL DEFAULT (datetime('now'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_reading_plans_user
+    ON reading_plans(user_id);
+`
+
 // migrationsSQL contains all database migrations in order.
 // Each migration is identified by its version number (key).
 var migrationsSQL = map[int]string{
 	1: migrationV1FreshSchema,
 	2: migrationV2ProgressTracking,
 	3: migrationV3UsersAndAPIKeys,
+	4: migrationV4ReadingSearch,
+	5: migrationV5Canticle,
+	6: migrationV6APIKeyExpiry,
+	7: migrationV7ReadingPlans,
+}
+
+// baselineMigration is the initial schema, which can't be rolled back.
+const baselineMigration = 1
+
+// migrationsDownSQL reverses each migration in migrationsSQL, keyed by the
+// same version. Every migration after the baseline must have one.
+var migrationsDownSQL = map[int]string{
+	2: `
+DROP TABLE IF EXISTS reading_progress;
+`,
+	3: `
+DROP INDEX IF EXISTS idx_reading_progress_user_id;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS users;
+`,
+	4: dropReadingsFTS,
+	5: dropReadingsFTS + migrationV4ReadingSearch + `
+ALTER TABLE daily_readings DROP COLUMN canticle;
+`,
+	6: `
+ALTER TABLE api_keys DROP COLUMN expires_at;
+`,
+	7: `
+DROP TABLE IF EXISTS reading_plans;
+`,
 }
@@ -1,7 +1,9 @@
 package database
 
 import (
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"time"
 )
@@ -20,6 +22,7 @@ type DailyReading struct {
 	FirstReading   string     `json:"first_reading"`             // "1 Kings 19:9-18"
 	SecondReading  string     `json:"second_reading"`            // "Ephesians 4:17-32"
 	GospelReading  string     `json:"gospel_reading"`            // "John 6:15-27"
+	Canticle       string     `json:"canticle,omitempty"`        // "Luke 1:46-55"; most days have none
 	LiturgicalInfo *string    `json:"liturgical_info,omitempty"` // Optional JSON metadata
 	SourceURL      string     `json:"source_url"`
 	ScrapedAt      *time.Time `json:"scraped_at,omitempty"`
@@ -27,6 +30,59 @@ type DailyReading struct {
 	UpdatedAt      time.Time  `json:"updated_at"`
 }
 
+// ReadingType identifies one of the scripture readings appointed for a day.
+type ReadingType string
+
+const (
+	ReadingTypeFirst  ReadingType = "first"
+	ReadingTypeSecond ReadingType = "second"
+	ReadingTypeGospel ReadingType = "gospel"
+
+	// ReadingTypeCanticle is an optional canticle read after the gospel
+	ReadingTypeCanticle ReadingType = "canticle"
+)
+
+// ValidReadingTypes lists the reading types in the order they are read.
+var ValidReadingTypes = []ReadingType{ReadingTypeFirst, ReadingTypeSecond, ReadingTypeGospel, ReadingTypeCanticle}
+
+// Optional reports whether days may lack this reading type without being
+// incomplete. Only the canticle is optional.
+func (t ReadingType) Optional() bool {
+	return t == ReadingTypeCanticle
+}
+
+// Reference returns the scripture reference for the given reading type,
+// or "" if the type is unknown.
+func (r *DailyReading) Reference(t ReadingType) string {
+	switch t {
+	case ReadingTypeFirst:
+		return r.FirstReading
+	case ReadingTypeSecond:
+		return r.SecondReading
+	case ReadingTypeGospel:
+		return r.GospelReading
+	case ReadingTypeCanticle:
+		return r.Canticle
+	}
+	return ""
+}
+
+// ReadingHash returns a short, stable content hash for one reading of a day,
+// derived from its type and reference. Clients can compare hashes to detect
+// which readings changed after an import.
+func ReadingHash(t ReadingType, reference string) string {
+	sum := sha256.Sum256([]byte(string(t) + "\x00" + reference))
+	return hex.EncodeToString(sum[:8])
+}
+
+// ReadingMatch is a single reading on a single day, as returned by
+// cross-date lookups such as "every reading from John".
+type ReadingMatch struct {
+	Date        string      `json:"date"`         // YYYY-MM-DD
+	ReadingType ReadingType `json:"reading_type"` // first, second, gospel, canticle
+	Reference   string      `json:"reference"`    // "John 16:23b-30"
+}
+
 // ScrapeLogEntry tracks a scraping attempt for debugging.
 type ScrapeLogEntry struct {
 	ID           int64     `json:"id"`
@@ -47,6 +103,17 @@ type ReadingStats struct {
 	LastScrapedAt *time.Time `json:"last_scraped_at,omitempty"`
 }
 
+// CompletenessStats summarizes how complete the stored readings are.
+// Percentages are 0-100; all are 0 when the database is empty.
+type CompletenessStats struct {
+	TotalDays               int     `json:"total_days"`                // Days stored
+	ExpectedDays            int     `json:"expected_days"`             // Calendar days from earliest to latest stored date
+	CoveragePercent         float64 `json:"coverage_percent"`          // Stored days out of expected days
+	CompleteReadingsPercent float64 `json:"complete_readings_percent"` // Days with first, second, and gospel readings
+	PsalmsPercent           float64 `json:"psalms_percent"`            // Days with morning and evening psalms
+	LiturgicalInfoPercent   float64 `json:"liturgical_info_percent"`   // Days with liturgical metadata
+}
+
 // =============================================================================
 // Progress Tracking Models (Date-Based)
 // =============================================================================
@@ -79,6 +146,35 @@ type ReadingWithProgress struct {
 	Completed bool             `json:"completed"`
 }
 
+// ReadingPlan is a user's goal to complete every day from StartDate for
+// LengthDays days, e.g. "read the daily office for 30 days".
+type ReadingPlan struct {
+	ID         int64     `json:"id"`
+	UserID     string    `json:"user_id"`
+	Name       string    `json:"name,omitempty"`
+	StartDate  string    `json:"start_date"` // YYYY-MM-DD
+	LengthDays int       `json:"length_days"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// EndDate returns the plan's last day (YYYY-MM-DD), inclusive.
+func (p *ReadingPlan) EndDate() string {
+	start, err := time.Parse("2006-01-02", p.StartDate)
+	if err != nil {
+		return p.StartDate
+	}
+	return start.AddDate(0, 0, p.LengthDays-1).Format("2006-01-02")
+}
+
+// PlanProgress is a reading plan with its completion computed from the
+// user's reading_progress entries in the plan's date range.
+type PlanProgress struct {
+	ReadingPlan
+	EndDate           string  `json:"end_date"`           // Last day of the plan (YYYY-MM-DD)
+	CompletedDays     int     `json:"completed_days"`     // Days in the range the user completed
+	CompletionPercent float64 `json:"completion_percent"` // Completed days out of LengthDays
+}
+
 // User represents a user of the API.
 type User struct {
 	ID          int64      `json:"id"`
@@ -101,6 +197,8 @@ type APIKey struct {
 	CreatedAt  time.Time  `json:"created_at"`
 	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
 	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"` // Nil means the key never expires
+	Expired    bool       `json:"expired"`              // Past ExpiresAt; the key no longer authenticates
 }
 
 // APIKeyWithPlaintext is returned when creating a new key.
@@ -8,8 +8,12 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/zapponejosh/lectionary-api/internal/calendar"
+	"github.com/zapponejosh/lectionary-api/internal/scripture"
 )
 
 // =============================================================================
@@ -55,6 +59,12 @@ func parseTimestamp(ns sql.NullString) *time.Time {
 		return &t
 	}
 
+	// Try go-sqlite3's format for bound time.Time values (e.g. scraped_at)
+	t, err = time.Parse("2006-01-02 15:04:05.999999999-07:00", ns.String)
+	if err == nil {
+		return &t
+	}
+
 	// If all fail, return nil
 	return nil
 }
@@ -63,27 +73,27 @@ func parseTimestamp(ns sql.NullString) *time.Time {
 // Daily Reading Queries
 // =============================================================================
 
-// GetReadingByDate retrieves readings for a specific date.
-// Returns ErrNotFound if the date doesn't exist in the database.
-//
-// This is the most common query - used for /api/v1/readings/date/{date}
-func (db *DB) GetReadingByDate(ctx context.Context, date string) (*DailyReading, error) {
-	query := `
-		SELECT 
-			id, date, 
+// readingColumns is the column list scanDailyReading expects, in order.
+const readingColumns = `
+			id, date,
 			morning_psalms, evening_psalms,
-			first_reading, second_reading, gospel_reading,
+			first_reading, second_reading, gospel_reading, canticle,
 			liturgical_info, source_url, scraped_at,
-			created_at, updated_at
-		FROM daily_readings
-		WHERE date = ?
-	`
+			created_at, updated_at`
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
 
+// scanDailyReading scans one row selected with readingColumns.
+// Scan errors are wrapped, so callers can still match sql.ErrNoRows.
+func scanDailyReading(row rowScanner) (*DailyReading, error) {
 	var reading DailyReading
 	var morningPsalmsJSON, eveningPsalmsJSON string
 	var liturgicalInfo, sourceURL, scrapedAtStr, createdAtStr, updatedAtStr sql.NullString
 
-	err := db.QueryRowContext(ctx, query, date).Scan(
+	err := row.Scan(
 		&reading.ID,
 		&reading.Date,
 		&morningPsalmsJSON,
@@ -91,18 +101,15 @@ func (db *DB) GetReadingByDate(ctx context.Context, date string) (*DailyReading,
 		&reading.FirstReading,
 		&reading.SecondReading,
 		&reading.GospelReading,
+		&reading.Canticle,
 		&liturgicalInfo,
 		&sourceURL,
 		&scrapedAtStr,
 		&createdAtStr,
 		&updatedAtStr,
 	)
-
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound
-		}
-		return nil, fmt.Errorf("query reading by date: %w", err)
+		return nil, fmt.Errorf("scan reading row: %w", err)
 	}
 
 	// Unmarshal JSON psalm arrays
@@ -134,18 +141,147 @@ func (db *DB) GetReadingByDate(ctx context.Context, date string) (*DailyReading,
 	return &reading, nil
 }
 
+// GetReadingByDate retrieves readings for a specific date.
+// Returns ErrNotFound if the date doesn't exist in the database.
+//
+// This is the most common query - used for /api/v1/readings/date/{date}
+func (db *DB) GetReadingByDate(ctx context.Context, date string) (*DailyReading, error) {
+	query := `SELECT` + readingColumns + `
+		FROM daily_readings
+		WHERE date = ?
+	`
+
+	reading, err := scanDailyReading(db.QueryRowContext(ctx, query, date))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query reading by date: %w", err)
+	}
+
+	return reading, nil
+}
+
+// GetReadingByID retrieves a day's readings by row ID.
+// Returns ErrNotFound if no reading has that ID.
+func (db *DB) GetReadingByID(ctx context.Context, id int64) (*DailyReading, error) {
+	query := `SELECT` + readingColumns + `
+		FROM daily_readings
+		WHERE id = ?
+	`
+
+	reading, err := scanDailyReading(db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("query reading by id: %w", err)
+	}
+
+	return reading, nil
+}
+
+// GetPsalmsByDate retrieves only the morning and evening psalms for a date.
+// Returns ErrNotFound if the date doesn't exist in the database.
+//
+// Used for /api/v1/psalms/today, which doesn't need the full reading row.
+func (db *DB) GetPsalmsByDate(ctx context.Context, date string) (morning, evening []string, err error) {
+	query := `
+		SELECT morning_psalms, evening_psalms
+		FROM daily_readings
+		WHERE date = ?
+	`
+
+	var morningJSON, eveningJSON string
+	err = db.QueryRowContext(ctx, query, date).Scan(&morningJSON, &eveningJSON)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("query psalms by date: %w", err)
+	}
+
+	morning, err = UnmarshalPsalms(morningJSON)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal morning psalms: %w", err)
+	}
+
+	evening, err = UnmarshalPsalms(eveningJSON)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal evening psalms: %w", err)
+	}
+
+	return morning, evening, nil
+}
+
 // GetReadingsByDateRange retrieves readings for a date range (inclusive).
 // Returns empty slice if no readings found in range.
 //
 // Used for /api/v1/readings/range?start=X&end=Y
 func (db *DB) GetReadingsByDateRange(ctx context.Context, startDate, endDate string) ([]DailyReading, error) {
-	query := `
-		SELECT 
-			id, date,
-			morning_psalms, evening_psalms,
-			first_reading, second_reading, gospel_reading,
-			liturgical_info, source_url, scraped_at,
-			created_at, updated_at
+	var readings []DailyReading
+
+	err := db.StreamReadingsByDateRange(ctx, startDate, endDate, func(reading *DailyReading) error {
+		readings = append(readings, *reading)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return readings, nil
+}
+
+// GetReadingsByDates retrieves the readings for a set of dates in one
+// query, in date order. Dates with no readings are simply absent.
+//
+// Used for POST /api/v1/readings/dates
+func (db *DB) GetReadingsByDates(ctx context.Context, dates []string) ([]DailyReading, error) {
+	if len(dates) == 0 {
+		return []DailyReading{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(dates)), ",")
+	query := `SELECT` + readingColumns + `
+		FROM daily_readings
+		WHERE date IN (` + placeholders + `)
+		ORDER BY date ASC
+	`
+
+	args := make([]interface{}, len(dates))
+	for i, date := range dates {
+		args[i] = date
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query readings by dates: %w", err)
+	}
+	defer rows.Close()
+
+	readings := []DailyReading{}
+	for rows.Next() {
+		reading, err := scanDailyReading(rows)
+		if err != nil {
+			return nil, err
+		}
+		readings = append(readings, *reading)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reading rows: %w", err)
+	}
+
+	return readings, nil
+}
+
+// StreamReadingsByDateRange calls fn for each reading in a date range
+// (inclusive), in date order, without holding the whole range in memory.
+// If fn returns an error, iteration stops and that error is returned.
+//
+// Used for NDJSON responses from /api/v1/readings/range
+func (db *DB) StreamReadingsByDateRange(ctx context.Context, startDate, endDate string, fn func(*DailyReading) error) error {
+	query := `SELECT` + readingColumns + `
 		FROM daily_readings
 		WHERE date >= ? AND date <= ?
 		ORDER BY date ASC
@@ -153,69 +289,196 @@ func (db *DB) GetReadingsByDateRange(ctx context.Context, startDate, endDate str
 
 	rows, err := db.QueryContext(ctx, query, startDate, endDate)
 	if err != nil {
-		return nil, fmt.Errorf("query readings by range: %w", err)
+		return fmt.Errorf("query readings by range: %w", err)
 	}
 	defer rows.Close()
 
-	var readings []DailyReading
+	for rows.Next() {
+		reading, err := scanDailyReading(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(reading); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("iterate reading rows: %w", err)
+	}
+
+	return nil
+}
+
+// GetReadingsByBook retrieves every first, second, gospel, and canticle reading that
+// cites the given book, ordered by date and then reading order.
+// Returns empty slice if the book is never read.
+//
+// A LIKE prefilter narrows the rows in SQL; the whole-book match is then
+// done by the scripture parser so "John" does not pick up "1 John".
+//
+// Used for /api/v1/book/{book}
+func (db *DB) GetReadingsByBook(ctx context.Context, book string) ([]ReadingMatch, error) {
+	book = scripture.NormalizeBook(book)
+	if book == "" {
+		return nil, nil
+	}
+
+	query := `
+		SELECT date, first_reading, second_reading, gospel_reading, canticle
+		FROM daily_readings
+		WHERE first_reading LIKE ?1
+		   OR second_reading LIKE ?1
+		   OR gospel_reading LIKE ?1
+		   OR canticle LIKE ?1
+		ORDER BY date ASC
+	`
+
+	rows, err := db.QueryContext(ctx, query, "%"+book+"%")
+	if err != nil {
+		return nil, fmt.Errorf("query readings by book: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []ReadingMatch
 
 	for rows.Next() {
 		var reading DailyReading
-		var morningPsalmsJSON, eveningPsalmsJSON string
-		var liturgicalInfo, sourceURL, scrapedAtStr, createdAtStr, updatedAtStr sql.NullString
-
-		err := rows.Scan(
-			&reading.ID,
+		if err := rows.Scan(
 			&reading.Date,
-			&morningPsalmsJSON,
-			&eveningPsalmsJSON,
 			&reading.FirstReading,
 			&reading.SecondReading,
 			&reading.GospelReading,
-			&liturgicalInfo,
-			&sourceURL,
-			&scrapedAtStr,
-			&createdAtStr,
-			&updatedAtStr,
-		)
-		if err != nil {
+			&reading.Canticle,
+		); err != nil {
 			return nil, fmt.Errorf("scan reading row: %w", err)
 		}
 
-		// Unmarshal JSON psalm arrays
-		reading.MorningPsalms, err = UnmarshalPsalms(morningPsalmsJSON)
-		if err != nil {
-			return nil, fmt.Errorf("unmarshal morning psalms: %w", err)
+		for _, t := range ValidReadingTypes {
+			ref := reading.Reference(t)
+			if scripture.HasBook(ref, book) {
+				matches = append(matches, ReadingMatch{
+					Date:        reading.Date,
+					ReadingType: t,
+					Reference:   ref,
+				})
+			}
 		}
+	}
 
-		reading.EveningPsalms, err = UnmarshalPsalms(eveningPsalmsJSON)
-		if err != nil {
-			return nil, fmt.Errorf("unmarshal evening psalms: %w", err)
-		}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reading rows: %w", err)
+	}
 
-		// Handle nullable fields
-		if liturgicalInfo.Valid {
-			reading.LiturgicalInfo = &liturgicalInfo.String
-		}
-		reading.SourceURL = NullString(sourceURL)
+	return matches, nil
+}
 
-		// Parse all timestamps from TEXT
-		reading.ScrapedAt = parseTimestamp(scrapedAtStr)
-		if t := parseTimestamp(createdAtStr); t != nil {
-			reading.CreatedAt = *t
+// GetReadingsByReference retrieves every first, second, gospel, and canticle reading
+// whose reference matches the given one after normalization, so "John 3:1–17"
+// (en dash) finds a stored "John 3:1-17". Returns empty slice if not found.
+//
+// Used for /api/v1/where?reference=...
+func (db *DB) GetReadingsByReference(ctx context.Context, reference string) ([]ReadingMatch, error) {
+	books := scripture.Books(scripture.Normalize(reference))
+	if len(books) == 0 {
+		return nil, nil
+	}
+
+	candidates, err := db.GetReadingsByBook(ctx, books[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []ReadingMatch
+	for _, m := range candidates {
+		if scripture.Equal(m.Reference, reference) {
+			matches = append(matches, m)
 		}
-		if t := parseTimestamp(updatedAtStr); t != nil {
-			reading.UpdatedAt = *t
+	}
+
+	return matches, nil
+}
+
+// SearchReadingsByReference full-text searches the first, second,
+// gospel, and canticle readings for a reference or part of one, so "Isaiah 40" finds
+// "Isaiah 40:1-11" and "John" finds both "John" and "1 John". Words must
+// appear together and in order. Returns empty slice if nothing matches.
+//
+// Used for /api/v1/search?reference=...
+func (db *DB) SearchReadingsByReference(ctx context.Context, reference string) ([]ReadingMatch, error) {
+	terms := searchTerms(scripture.Normalize(reference))
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT d.date, d.first_reading, d.second_reading, d.gospel_reading, d.canticle
+		FROM readings_fts
+		JOIN daily_readings d ON d.id = readings_fts.docid
+		WHERE readings_fts MATCH ?
+		ORDER BY d.date ASC
+	`
+
+	// A quoted phrase matches the terms consecutively within one column
+	phrase := `"` + strings.Join(terms, " ") + `"`
+
+	rows, err := db.QueryContext(ctx, query, phrase)
+	if err != nil {
+		return nil, fmt.Errorf("search readings: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []ReadingMatch
+
+	for rows.Next() {
+		var reading DailyReading
+		if err := rows.Scan(
+			&reading.Date,
+			&reading.FirstReading,
+			&reading.SecondReading,
+			&reading.GospelReading,
+			&reading.Canticle,
+		); err != nil {
+			return nil, fmt.Errorf("scan reading row: %w", err)
 		}
 
-		readings = append(readings, reading)
+		// The row matched; report the reading types that did
+		for _, t := range ValidReadingTypes {
+			ref := reading.Reference(t)
+			if containsTerms(searchTerms(ref), terms) {
+				matches = append(matches, ReadingMatch{
+					Date:        reading.Date,
+					ReadingType: t,
+					Reference:   ref,
+				})
+			}
+		}
 	}
 
 	if err = rows.Err(); err != nil {
 		return nil, fmt.Errorf("iterate reading rows: %w", err)
 	}
 
-	return readings, nil
+	return matches, nil
+}
+
+// searchTerms splits text into lowercase words the way the FTS "simple"
+// tokenizer does: runs of ASCII letters and digits. It also keeps user
+// input from reaching MATCH as query syntax.
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+}
+
+// containsTerms reports whether terms appear consecutively in words.
+func containsTerms(words, terms []string) bool {
+	for i := 0; i+len(terms) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(terms)], terms) {
+			return true
+		}
+	}
+	return false
 }
 
 // UpsertDailyReading inserts or updates a daily reading.
@@ -244,15 +507,16 @@ func (db *DB) UpsertDailyReading(ctx context.Context, reading *DailyReading) err
 	query := `
 		INSERT INTO daily_readings (
 			date, morning_psalms, evening_psalms,
-			first_reading, second_reading, gospel_reading,
+			first_reading, second_reading, gospel_reading, canticle,
 			liturgical_info, source_url, scraped_at, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, datetime('now'))
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, datetime('now'))
 		ON CONFLICT(date) DO UPDATE SET
 			morning_psalms = excluded.morning_psalms,
 			evening_psalms = excluded.evening_psalms,
 			first_reading = excluded.first_reading,
 			second_reading = excluded.second_reading,
 			gospel_reading = excluded.gospel_reading,
+			canticle = excluded.canticle,
 			liturgical_info = excluded.liturgical_info,
 			source_url = excluded.source_url,
 			scraped_at = excluded.scraped_at,
@@ -266,6 +530,7 @@ func (db *DB) UpsertDailyReading(ctx context.Context, reading *DailyReading) err
 		reading.FirstReading,
 		reading.SecondReading,
 		reading.GospelReading,
+		reading.Canticle,
 		reading.LiturgicalInfo,
 		reading.SourceURL,
 		TimeToNullTime(reading.ScrapedAt),
@@ -278,6 +543,144 @@ func (db *DB) UpsertDailyReading(ctx context.Context, reading *DailyReading) err
 	return nil
 }
 
+// maxBatchParams keeps batched statements under SQLite's bound-parameter
+// limit (SQLITE_MAX_VARIABLE_NUMBER, 999 before SQLite 3.32).
+const maxBatchParams = 999
+
+// dailyReadingParams is the number of parameters per row in a batch upsert.
+const dailyReadingParams = 10
+
+// UpsertDailyReadings inserts or updates many daily readings with
+// multi-row statements, chunked to stay under SQLite's parameter limit.
+// It is much faster than calling UpsertDailyReading per row for a full
+// import. Same idempotent semantics as UpsertDailyReading.
+//
+// Returns how many readings were new and how many replaced an existing
+// date. IDs are not populated on the passed readings; look them up by
+// date if needed.
+func (tx *Tx) UpsertDailyReadings(ctx context.Context, readings []DailyReading) (inserted, updated int, err error) {
+	chunkSize := maxBatchParams / dailyReadingParams
+
+	for start := 0; start < len(readings); start += chunkSize {
+		chunk := readings[start:min(start+chunkSize, len(readings))]
+
+		existing, err := tx.countExistingDates(ctx, chunk)
+		if err != nil {
+			return inserted, updated, err
+		}
+
+		args := make([]any, 0, len(chunk)*dailyReadingParams)
+		for _, reading := range chunk {
+			morningPsalmsJSON, err := MarshalPsalms(reading.MorningPsalms)
+			if err != nil {
+				return inserted, updated, fmt.Errorf("marshal morning psalms for %s: %w", reading.Date, err)
+			}
+			eveningPsalmsJSON, err := MarshalPsalms(reading.EveningPsalms)
+			if err != nil {
+				return inserted, updated, fmt.Errorf("marshal evening psalms for %s: %w", reading.Date, err)
+			}
+
+			args = append(args,
+				reading.Date,
+				morningPsalmsJSON,
+				eveningPsalmsJSON,
+				reading.FirstReading,
+				reading.SecondReading,
+				reading.GospelReading,
+				reading.Canticle,
+				reading.LiturgicalInfo,
+				reading.SourceURL,
+				TimeToNullTime(reading.ScrapedAt),
+			)
+		}
+
+		values := strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, datetime('now')), ", len(chunk)), ", ")
+		query := `
+			INSERT INTO daily_readings (
+				date, morning_psalms, evening_psalms,
+				first_reading, second_reading, gospel_reading, canticle,
+				liturgical_info, source_url, scraped_at, updated_at
+			) VALUES ` + values + `
+			ON CONFLICT(date) DO UPDATE SET
+				morning_psalms = excluded.morning_psalms,
+				evening_psalms = excluded.evening_psalms,
+				first_reading = excluded.first_reading,
+				second_reading = excluded.second_reading,
+				gospel_reading = excluded.gospel_reading,
+				canticle = excluded.canticle,
+				liturgical_info = excluded.liturgical_info,
+				source_url = excluded.source_url,
+				scraped_at = excluded.scraped_at,
+				updated_at = datetime('now')
+		`
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return inserted, updated, fmt.Errorf("batch upsert daily readings: %w", err)
+		}
+
+		inserted += len(chunk) - existing
+		updated += existing
+	}
+
+	return inserted, updated, nil
+}
+
+// countExistingDates counts how many of the readings' dates are already
+// stored, so batch upserts can report inserts and updates separately.
+func (tx *Tx) countExistingDates(ctx context.Context, readings []DailyReading) (int, error) {
+	args := make([]any, len(readings))
+	for i, reading := range readings {
+		args[i] = reading.Date
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(readings)), ", ")
+	query := `SELECT COUNT(*) FROM daily_readings WHERE date IN (` + placeholders + `)`
+
+	var count int
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count existing readings: %w", err)
+	}
+	return count, nil
+}
+
+// readingColumn maps a reading type to its daily_readings column.
+var readingColumn = map[ReadingType]string{
+	ReadingTypeFirst:    "first_reading",
+	ReadingTypeSecond:   "second_reading",
+	ReadingTypeGospel:   "gospel_reading",
+	ReadingTypeCanticle: "canticle",
+}
+
+// UpdateReading replaces one scripture reference of a stored day, e.g. to
+// fix a typo without re-importing, and bumps updated_at.
+// Returns ErrNotFound if no reading has the ID.
+//
+// Used for PATCH /api/v1/admin/readings/{id}
+func (db *DB) UpdateReading(ctx context.Context, id int64, t ReadingType, reference string) error {
+	column, ok := readingColumn[t]
+	if !ok {
+		return fmt.Errorf("unknown reading type %q", t)
+	}
+
+	query := `UPDATE daily_readings SET ` + column + ` = ?, updated_at = datetime('now') WHERE id = ?`
+
+	result, err := db.ExecContext(ctx, query, reference, id)
+	if err != nil {
+		return fmt.Errorf("update reading: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("check rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 // DeleteDailyReading removes a reading by date.
 // Returns ErrNotFound if date doesn't exist.
 //
@@ -352,6 +755,65 @@ func (db *DB) GetReadingStats(ctx context.Context) (*ReadingStats, error) {
 	return &stats, nil
 }
 
+// GetCompletenessStats computes data-quality percentages over the stored
+// readings. Coverage is measured against every calendar day between the
+// earliest and latest stored dates, so gaps in the middle show up.
+//
+// Used for /api/v1/admin/completeness
+func (db *DB) GetCompletenessStats(ctx context.Context) (*CompletenessStats, error) {
+	query := `
+		SELECT
+			COUNT(*),
+			COALESCE(MIN(date), ''),
+			COALESCE(MAX(date), ''),
+			COALESCE(SUM(first_reading != '' AND second_reading != '' AND gospel_reading != ''), 0),
+			COALESCE(SUM(morning_psalms NOT IN ('', '[]') AND evening_psalms NOT IN ('', '[]')), 0),
+			COALESCE(SUM(liturgical_info IS NOT NULL AND liturgical_info != ''), 0)
+		FROM daily_readings
+	`
+
+	var stats CompletenessStats
+	var earliest, latest string
+	var completeReadings, withPsalms, withInfo int
+
+	err := db.QueryRowContext(ctx, query).Scan(
+		&stats.TotalDays,
+		&earliest,
+		&latest,
+		&completeReadings,
+		&withPsalms,
+		&withInfo,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query completeness stats: %w", err)
+	}
+
+	if stats.TotalDays == 0 {
+		return &stats, nil
+	}
+
+	start, err := time.Parse("2006-01-02", earliest)
+	if err != nil {
+		return nil, fmt.Errorf("parse earliest date %q: %w", earliest, err)
+	}
+	end, err := time.Parse("2006-01-02", latest)
+	if err != nil {
+		return nil, fmt.Errorf("parse latest date %q: %w", latest, err)
+	}
+	stats.ExpectedDays = calendar.DaysBetween(start, end) + 1
+
+	percent := func(n, total int) float64 {
+		return (float64(n) / float64(total)) * 100.0
+	}
+
+	stats.CoveragePercent = percent(stats.TotalDays, stats.ExpectedDays)
+	stats.CompleteReadingsPercent = percent(completeReadings, stats.TotalDays)
+	stats.PsalmsPercent = percent(withPsalms, stats.TotalDays)
+	stats.LiturgicalInfoPercent = percent(withInfo, stats.TotalDays)
+
+	return &stats, nil
+}
+
 // =============================================================================
 // Scrape Log Queries
 // =============================================================================
@@ -489,6 +951,36 @@ func (db *DB) CreateProgress(ctx context.Context, progress *ReadingProgress) err
 	return nil
 }
 
+// MarkDayComplete records a day as complete unless the user already has,
+// in which case the existing entry (and its notes and completed_at) is
+// left alone. Reports whether a new entry was inserted.
+func (db *DB) MarkDayComplete(ctx context.Context, progress *ReadingProgress) (bool, error) {
+	query := `
+		INSERT INTO reading_progress (user_id, reading_date, notes, completed_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id, reading_date) DO NOTHING
+	`
+
+	result, err := db.ExecContext(ctx, query,
+		progress.UserID,
+		progress.ReadingDate,
+		progress.Notes,
+		progress.CompletedAt.Format("2006-01-02 15:04:05"),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "FOREIGN KEY constraint") {
+			return false, fmt.Errorf("reading date not found in database")
+		}
+		return false, fmt.Errorf("mark day complete: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
 // GetProgressByUser retrieves a user's reading progress with pagination.
 // Results are ordered by completion date (most recent first).
 func (db *DB) GetProgressByUser(ctx context.Context, userID string, limit, offset int) ([]ReadingProgress, error) {
@@ -506,6 +998,41 @@ func (db *DB) GetProgressByUser(ctx context.Context, userID string, limit, offse
 	}
 	defer rows.Close()
 
+	return scanProgress(rows)
+}
+
+// GetProgressByUserInRange is GetProgressByUser limited to entries
+// completed between from and to (YYYY-MM-DD, inclusive), e.g. to answer
+// "what did I complete in Lent?". Either bound may be empty to leave
+// that end open.
+func (db *DB) GetProgressByUserInRange(ctx context.Context, userID, from, to string, limit, offset int) ([]ReadingProgress, error) {
+	if from == "" {
+		from = "0000-01-01"
+	}
+	if to == "" {
+		to = "9999-12-31"
+	}
+
+	query := `
+		SELECT id, user_id, reading_date, notes, completed_at, created_at, updated_at
+		FROM reading_progress
+		WHERE user_id = ? AND DATE(completed_at) BETWEEN ? AND ?
+		ORDER BY completed_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := db.QueryContext(ctx, query, userID, from, to, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("query progress by user in range: %w", err)
+	}
+	defer rows.Close()
+
+	return scanProgress(rows)
+}
+
+// scanProgress reads reading_progress rows selected as id, user_id,
+// reading_date, notes, completed_at, created_at, updated_at.
+func scanProgress(rows *sql.Rows) ([]ReadingProgress, error) {
 	var progressList []ReadingProgress
 
 	for rows.Next() {
@@ -625,6 +1152,53 @@ func (db *DB) DeleteProgress(ctx context.Context, userID string, date string) er
 	return nil
 }
 
+// DeleteAllProgressForUser removes every progress entry belonging to a
+// user, for starting a new reading year. Returns how many were removed.
+func (db *DB) DeleteAllProgressForUser(ctx context.Context, userID string) (int64, error) {
+	result, err := db.ExecContext(ctx, `DELETE FROM reading_progress WHERE user_id = ?`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("delete all progress: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateProgressNotes replaces the notes on a user's progress entry for a
+// date, keeping its completed_at. Nil or empty notes clear them (NULL).
+// Returns ErrNotFound if the user has no entry for that date.
+func (db *DB) UpdateProgressNotes(ctx context.Context, userID string, date string, notes *string) error {
+	if notes != nil && *notes == "" {
+		notes = nil
+	}
+
+	query := `
+		UPDATE reading_progress
+		SET notes = ?, updated_at = datetime('now')
+		WHERE user_id = ? AND reading_date = ?
+	`
+
+	result, err := db.ExecContext(ctx, query, notes, userID, date)
+	if err != nil {
+		return fmt.Errorf("update progress notes: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
 // GetProgressStats calculates reading statistics for a user.
 func (db *DB) GetProgressStats(ctx context.Context, userID string) (*ProgressStats, error) {
 	// Get total days available in database
@@ -687,11 +1261,13 @@ func (db *DB) GetProgressStats(ctx context.Context, userID string) (*ProgressSta
 // Current streak: consecutive days ending today or yesterday.
 // Longest streak: best streak in history.
 func (db *DB) calculateStreaks(ctx context.Context, userID string) (current, longest int) {
+	// DATE() normalizes any stored timestamps to calendar days, and
+	// DISTINCT folds several completions on one day into one
 	query := `
-		SELECT DATE(reading_date) as date
+		SELECT DISTINCT DATE(reading_date) AS date
 		FROM reading_progress
-		WHERE user_id = ?
-		ORDER BY date DESC
+		WHERE user_id = ? AND DATE(reading_date) IS NOT NULL
+		ORDER BY date ASC
 	`
 
 	rows, err := db.QueryContext(ctx, query, userID)
@@ -700,58 +1276,136 @@ func (db *DB) calculateStreaks(ctx context.Context, userID string) (current, lon
 	}
 	defer rows.Close()
 
-	var dates []string
+	var days []time.Time
 	for rows.Next() {
 		var date string
 		if err := rows.Scan(&date); err != nil {
 			continue
 		}
-		dates = append(dates, date)
+		day, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			continue
+		}
+		days = append(days, day)
 	}
 
-	if len(dates) == 0 {
-		return 0, 0
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return streaks(days, today)
+}
+
+// streaks computes the current and longest runs of consecutive days in
+// days, which must be distinct calendar days (midnight UTC) in ascending
+// order. The current run counts only if it ends today or yesterday.
+func streaks(days []time.Time, today time.Time) (current, longest int) {
+	run := 0
+	for i, day := range days {
+		if i > 0 && day.Equal(days[i-1].AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
 	}
 
-	// Calculate current streak (must end today or yesterday)
-	today := time.Now().Format("2006-01-02")
-	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	if len(days) > 0 {
+		last := days[len(days)-1]
+		if last.Equal(today) || last.Equal(today.AddDate(0, 0, -1)) {
+			current = run
+		}
+	}
 
-	currentStreak := 0
-	if dates[0] == today || dates[0] == yesterday {
-		expectedDate, _ := time.Parse("2006-01-02", dates[0])
-		currentStreak = 1
+	return current, longest
+}
 
-		for i := 1; i < len(dates); i++ {
-			expectedDate = expectedDate.AddDate(0, 0, -1)
+// =============================================================================
+// Reading Plan Queries
+// =============================================================================
 
-			if dates[i] == expectedDate.Format("2006-01-02") {
-				currentStreak++
-			} else {
-				break
-			}
-		}
+// CreateReadingPlan stores a new plan, filling in its ID and CreatedAt.
+func (db *DB) CreateReadingPlan(ctx context.Context, plan *ReadingPlan) error {
+	query := `
+		INSERT INTO reading_plans (user_id, name, start_date, length_days)
+		VALUES (?, ?, ?, ?)
+	`
+
+	result, err := db.ExecContext(ctx, query, plan.UserID, plan.Name, plan.StartDate, plan.LengthDays)
+	if err != nil {
+		return fmt.Errorf("insert reading plan: %w", err)
 	}
 
-	// Calculate longest streak
-	longestStreak := currentStreak
-	streak := 1
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("get last insert id: %w", err)
+	}
 
-	for i := 1; i < len(dates); i++ {
-		prevDate, _ := time.Parse("2006-01-02", dates[i-1])
-		expectedDate := prevDate.AddDate(0, 0, -1)
+	plan.ID = id
+	plan.CreatedAt = time.Now()
 
-		if dates[i] == expectedDate.Format("2006-01-02") {
-			streak++
-			if streak > longestStreak {
-				longestStreak = streak
-			}
-		} else {
-			streak = 1
-		}
+	return nil
+}
+
+// GetReadingPlan retrieves one of a user's plans by ID.
+// Returns ErrNotFound if the plan doesn't exist or belongs to another user.
+func (db *DB) GetReadingPlan(ctx context.Context, userID string, id int64) (*ReadingPlan, error) {
+	query := `
+		SELECT id, user_id, name, start_date, length_days, created_at
+		FROM reading_plans
+		WHERE id = ? AND user_id = ?
+	`
+
+	var p ReadingPlan
+	var createdAtStr sql.NullString
+
+	err := db.QueryRowContext(ctx, query, id, userID).Scan(
+		&p.ID,
+		&p.UserID,
+		&p.Name,
+		&p.StartDate,
+		&p.LengthDays,
+		&createdAtStr,
+	)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query reading plan: %w", err)
+	}
+
+	if t := parseTimestamp(createdAtStr); t != nil {
+		p.CreatedAt = *t
 	}
 
-	return currentStreak, longestStreak
+	return &p, nil
+}
+
+// GetPlanProgress computes a plan's completion: the days from its start
+// through its end date that the user has marked complete, whenever they
+// marked them.
+func (db *DB) GetPlanProgress(ctx context.Context, plan *ReadingPlan) (*PlanProgress, error) {
+	endDate := plan.EndDate()
+
+	query := `
+		SELECT COUNT(*)
+		FROM reading_progress
+		WHERE user_id = ? AND reading_date BETWEEN ? AND ?
+	`
+	var completedDays int
+	if err := db.QueryRowContext(ctx, query, plan.UserID, plan.StartDate, endDate).Scan(&completedDays); err != nil {
+		return nil, fmt.Errorf("count plan days completed: %w", err)
+	}
+
+	completionPercent := 0.0
+	if plan.LengthDays > 0 {
+		completionPercent = (float64(completedDays) / float64(plan.LengthDays)) * 100.0
+	}
+
+	return &PlanProgress{
+		ReadingPlan:       *plan,
+		EndDate:           endDate,
+		CompletedDays:     completedDays,
+		CompletionPercent: completionPercent,
+	}, nil
 }
 
 // ============================================================================
@@ -950,8 +1604,9 @@ func (db *DB) ListUsers(ctx context.Context) ([]User, error) {
 // ============================================================================
 
 // ValidateAPIKey checks if a key is valid and returns the user.
-// Returns ErrNotFound if key doesn't exist or is inactive.
-// Updates last_used_at timestamp.
+// Returns ErrNotFound if key doesn't exist or is inactive, and
+// ErrKeyExpired if it is past its expiry.
+// It does not record usage; call TouchAPIKey for that.
 func (db *DB) ValidateAPIKey(ctx context.Context, apiKey string) (*User, error) {
 	// Hash the provided key
 	hash := sha256.Sum256([]byte(apiKey))
@@ -960,17 +1615,17 @@ func (db *DB) ValidateAPIKey(ctx context.Context, apiKey string) (*User, error)
 	query := `
 		SELECT u.id, u.username, u.email, u.full_name, u.active,
 		       u.created_at, u.updated_at, u.last_login_at,
-		       k.id as key_id
+		       k.expires_at IS NOT NULL AND k.expires_at <= datetime('now') AS expired
 		FROM users u
 		INNER JOIN api_keys k ON k.user_id = u.id
 		WHERE k.key_hash = ? AND k.active = 1 AND u.active = 1
 	`
 
 	var u User
-	var keyID int64
 	var email, fullName sql.NullString
 	var lastLoginAt sql.NullString
 	var createdAtStr, updatedAtStr string
+	var expired bool
 
 	err := db.QueryRowContext(ctx, query, keyHash).Scan(
 		&u.ID,
@@ -981,7 +1636,7 @@ func (db *DB) ValidateAPIKey(ctx context.Context, apiKey string) (*User, error)
 		&createdAtStr,
 		&updatedAtStr,
 		&lastLoginAt,
-		&keyID,
+		&expired,
 	)
 
 	if err == sql.ErrNoRows {
@@ -990,6 +1645,9 @@ func (db *DB) ValidateAPIKey(ctx context.Context, apiKey string) (*User, error)
 	if err != nil {
 		return nil, fmt.Errorf("validate api key: %w", err)
 	}
+	if expired {
+		return nil, ErrKeyExpired
+	}
 
 	if email.Valid {
 		u.Email = &email.String
@@ -1007,21 +1665,35 @@ func (db *DB) ValidateAPIKey(ctx context.Context, apiKey string) (*User, error)
 		u.LastLoginAt = t
 	}
 
-	// Update last_used_at (async, don't block)
-	go func() {
-		updateQuery := `UPDATE api_keys SET last_used_at = datetime('now') WHERE id = ?`
-		db.ExecContext(context.Background(), updateQuery, keyID)
+	return &u, nil
+}
+
+// TouchAPIKey records that a key was just used: it sets the key's
+// last_used_at and its user's last_login_at to now.
+func (db *DB) TouchAPIKey(ctx context.Context, apiKey string) error {
+	hash := sha256.Sum256([]byte(apiKey))
+	keyHash := hex.EncodeToString(hash[:])
 
-		// Also update user's last_login_at
-		db.UpdateUserLastLogin(context.Background(), u.ID)
-	}()
+	query := `UPDATE api_keys SET last_used_at = datetime('now') WHERE key_hash = ?`
+	if _, err := db.ExecContext(ctx, query, keyHash); err != nil {
+		return fmt.Errorf("touch api key: %w", err)
+	}
 
-	return &u, nil
+	query = `
+		UPDATE users SET last_login_at = datetime('now')
+		WHERE id = (SELECT user_id FROM api_keys WHERE key_hash = ?)
+	`
+	if _, err := db.ExecContext(ctx, query, keyHash); err != nil {
+		return fmt.Errorf("touch api key user: %w", err)
+	}
+
+	return nil
 }
 
 // CreateAPIKey generates and stores a new API key for a user.
+// A positive ttl makes the key expire that long from now; zero means never.
 // Returns the plaintext key (only time it's visible) and the record.
-func (db *DB) CreateAPIKey(ctx context.Context, userID int64, name string) (*APIKeyWithPlaintext, error) {
+func (db *DB) CreateAPIKey(ctx context.Context, userID int64, name string, ttl time.Duration) (*APIKeyWithPlaintext, error) {
 	// Verify user exists
 	_, err := db.GetUserByID(ctx, userID)
 	if err != nil {
@@ -1039,12 +1711,22 @@ func (db *DB) CreateAPIKey(ctx context.Context, userID int64, name string) (*API
 	hash := sha256.Sum256([]byte(plainKey))
 	keyHash := hex.EncodeToString(hash[:])
 
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var expiresAt *time.Time
+	var expiresAtStr sql.NullString
+	if ttl > 0 {
+		t := now.Add(ttl)
+		expiresAt = &t
+		expiresAtStr = sql.NullString{String: t.Format("2006-01-02 15:04:05"), Valid: true}
+	}
+
 	query := `
-		INSERT INTO api_keys (user_id, key_hash, name, active)
-		VALUES (?, ?, ?, 1)
+		INSERT INTO api_keys (user_id, key_hash, name, active, expires_at)
+		VALUES (?, ?, ?, 1, ?)
 	`
 
-	result, err := db.ExecContext(ctx, query, userID, keyHash, name)
+	result, err := db.ExecContext(ctx, query, userID, keyHash, name, expiresAtStr)
 	if err != nil {
 		return nil, fmt.Errorf("insert api key: %w", err)
 	}
@@ -1058,7 +1740,8 @@ func (db *DB) CreateAPIKey(ctx context.Context, userID int64, name string) (*API
 			KeyHash:   keyHash,
 			Name:      name,
 			Active:    true,
-			CreatedAt: time.Now(),
+			CreatedAt: now,
+			ExpiresAt: expiresAt,
 		},
 		PlaintextKey: plainKey,
 	}, nil
@@ -1068,7 +1751,7 @@ func (db *DB) CreateAPIKey(ctx context.Context, userID int64, name string) (*API
 func (db *DB) ListUserAPIKeys(ctx context.Context, userID int64) ([]APIKey, error) {
 	query := `
 		SELECT id, user_id, key_hash, name, active, 
-		       created_at, last_used_at, revoked_at
+		       created_at, last_used_at, revoked_at, expires_at
 		FROM api_keys
 		WHERE user_id = ?
 		ORDER BY created_at DESC
@@ -1080,11 +1763,60 @@ func (db *DB) ListUserAPIKeys(ctx context.Context, userID int64) ([]APIKey, erro
 	}
 	defer rows.Close()
 
+	return scanAPIKeys(rows)
+}
+
+// ListUsersWithKeys returns all users with their API keys (admin only),
+// so admins can see when each key was last used.
+func (db *DB) ListUsersWithKeys(ctx context.Context) ([]UserWithKeys, error) {
+	users, err := db.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, user_id, key_hash, name, active,
+		       created_at, last_used_at, revoked_at, expires_at
+		FROM api_keys
+		ORDER BY created_at DESC
+	`
+
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys, err := scanAPIKeys(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[int64][]APIKey)
+	for _, k := range keys {
+		byUser[k.UserID] = append(byUser[k.UserID], k)
+	}
+
+	result := make([]UserWithKeys, len(users))
+	for i, u := range users {
+		result[i] = UserWithKeys{User: u, APIKeys: byUser[u.ID]}
+		if result[i].APIKeys == nil {
+			result[i].APIKeys = []APIKey{}
+		}
+	}
+	return result, nil
+}
+
+// scanAPIKeys reads api_keys rows selected as id, user_id, key_hash, name,
+// active, created_at, last_used_at, revoked_at, expires_at.
+func scanAPIKeys(rows *sql.Rows) ([]APIKey, error) {
+	now := time.Now()
+
 	var keys []APIKey
 	for rows.Next() {
 		var k APIKey
 		var createdAtStr string
-		var lastUsedAt, revokedAt sql.NullString
+		var lastUsedAt, revokedAt, expiresAt sql.NullString
 
 		err := rows.Scan(
 			&k.ID,
@@ -1095,6 +1827,7 @@ func (db *DB) ListUserAPIKeys(ctx context.Context, userID int64) ([]APIKey, erro
 			&createdAtStr,
 			&lastUsedAt,
 			&revokedAt,
+			&expiresAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan api key: %w", err)
@@ -1109,10 +1842,18 @@ func (db *DB) ListUserAPIKeys(ctx context.Context, userID int64) ([]APIKey, erro
 		if t := parseTimestamp(revokedAt); t != nil {
 			k.RevokedAt = t
 		}
+		if t := parseTimestamp(expiresAt); t != nil {
+			k.ExpiresAt = t
+			k.Expired = !now.Before(*t)
+		}
 
 		keys = append(keys, k)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate api keys: %w", err)
+	}
+
 	return keys, nil
 }
 
@@ -0,0 +1,411 @@
+// Package importer loads scraped lectionary readings into the database,
+// and exports the database back out in the same format.
+//
+// It is shared by the import command, which writes to the real database,
+// the admin preflight endpoint, which imports into a throwaway in-memory
+// database to check a dataset before it goes live, and the export command.
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zapponejosh/lectionary-api/internal/database"
+	"github.com/zapponejosh/lectionary-api/internal/scripture"
+)
+
+// =============================================================================
+// Scraper JSON Format
+// =============================================================================
+
+// ScraperReading represents a single reading from the scraper output.
+type ScraperReading struct {
+	Morning       string `json:"Morning"`
+	FirstReading  string `json:"First Reading"`
+	SecondReading string `json:"Second Reading"`
+	GospelReading string `json:"Gospel"`
+	Canticle      string `json:"Canticle,omitempty"`
+	Evening       string `json:"Evening"`
+}
+
+// ScraperDateEntry represents one date's data from the scraper.
+type ScraperDateEntry struct {
+	Date      string         `json:"date"`
+	URL       string         `json:"url"`
+	Readings  ScraperReading `json:"readings"`
+	ScrapedAt string         `json:"scraped_at"`
+}
+
+// ScraperMetadata contains scraper metadata.
+type ScraperMetadata struct {
+	ExportedAt string            `json:"exported_at"`
+	TotalDates int               `json:"total_dates"`
+	Source     string            `json:"source"`
+	DateRange  *ScraperDateRange `json:"date_range"`
+}
+
+// ScraperDateRange is the first and last date in a scraper file.
+type ScraperDateRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// ScraperData represents the complete scraper output file.
+type ScraperData struct {
+	Metadata       ScraperMetadata             `json:"metadata"`
+	ReadingsByDate map[string]ScraperDateEntry `json:"readings_by_date"`
+}
+
+// Parse decodes scraper JSON output.
+func Parse(data []byte) (*ScraperData, error) {
+	var scraperData ScraperData
+	if err := json.Unmarshal(data, &scraperData); err != nil {
+		return nil, fmt.Errorf("parse JSON: %w", err)
+	}
+	return &scraperData, nil
+}
+
+// Dates returns the dataset's dates in ascending order.
+func (d *ScraperData) Dates() []string {
+	dates := make([]string, 0, len(d.ReadingsByDate))
+	for date := range d.ReadingsByDate {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	return dates
+}
+
+// =============================================================================
+// Import Functions
+// =============================================================================
+
+// Stats tracks import statistics.
+type Stats struct {
+	Imported int
+	Updated  int
+	Failed   int
+}
+
+// Import upserts every entry in the dataset, in date order.
+//
+// The import is idempotent - running it multiple times is safe.
+// Entries are written in batches inside one transaction. If that fails,
+// the import falls back to one entry at a time so that a bad entry is
+// logged and counted rather than aborting the run.
+func Import(ctx context.Context, db *database.DB, data *ScraperData, logger *slog.Logger) (*Stats, error) {
+	dates := data.Dates()
+
+	readings := make([]database.DailyReading, 0, len(dates))
+	for _, date := range dates {
+		readings = append(readings, *newDailyReading(data.ReadingsByDate[date], logger))
+	}
+
+	stats := &Stats{}
+	err := db.WithTx(ctx, func(tx *database.Tx) error {
+		var err error
+		stats.Imported, stats.Updated, err = tx.UpsertDailyReadings(ctx, readings)
+		return err
+	})
+	if err == nil {
+		return stats, nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return &Stats{}, ctxErr
+	}
+
+	logger.Warn("batch import failed, importing one at a time",
+		slog.String("error", err.Error()),
+	)
+
+	stats = &Stats{}
+	for i := range readings {
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+
+		if err := importReading(ctx, db, &readings[i], logger, stats); err != nil {
+			logger.Warn("failed to import reading",
+				slog.String("date", readings[i].Date),
+				slog.String("error", err.Error()),
+			)
+			stats.Failed++
+		}
+	}
+
+	return stats, nil
+}
+
+// scrapedAtLayout matches Python's datetime.isoformat(), e.g.
+// "2026-01-03T12:04:24.723240".
+const scrapedAtLayout = "2006-01-02T15:04:05.999999"
+
+// newDailyReading converts a scraper entry into a database reading.
+func newDailyReading(entry ScraperDateEntry, logger *slog.Logger) *database.DailyReading {
+	// Parse scraped_at timestamp
+	// Try parsing with microseconds (Python's isoformat)
+	scrapedAt, err := time.Parse(scrapedAtLayout, entry.ScrapedAt)
+	if err != nil {
+		// Try RFC3339 format
+		scrapedAt, err = time.Parse(time.RFC3339, entry.ScrapedAt)
+		if err != nil {
+			logger.Debug("could not parse scraped_at timestamp",
+				slog.String("date", entry.Date),
+				slog.String("scraped_at", entry.ScrapedAt),
+				slog.String("error", err.Error()),
+			)
+			scrapedAt = time.Now() // fallback to now
+		}
+	}
+
+	return &database.DailyReading{
+		Date:          entry.Date,
+		MorningPsalms: parsePsalms(entry.Readings.Morning),
+		EveningPsalms: parsePsalms(entry.Readings.Evening),
+		FirstReading:  entry.Readings.FirstReading,
+		SecondReading: entry.Readings.SecondReading,
+		GospelReading: entry.Readings.GospelReading,
+		Canticle:      entry.Readings.Canticle,
+		SourceURL:     entry.URL,
+		ScrapedAt:     &scrapedAt,
+	}
+}
+
+// importReading imports a single reading into the database.
+func importReading(ctx context.Context, db *database.DB, reading *database.DailyReading, logger *slog.Logger, stats *Stats) error {
+	// Check if it already exists (for stats)
+	existing, err := db.GetReadingByDate(ctx, reading.Date)
+	if err != nil && !database.IsNotFound(err) {
+		return fmt.Errorf("check existing reading: %w", err)
+	}
+
+	// Upsert (insert or update)
+	if err := db.UpsertDailyReading(ctx, reading); err != nil {
+		return fmt.Errorf("upsert reading: %w", err)
+	}
+
+	if existing != nil {
+		stats.Updated++
+		logger.Debug("updated reading", slog.String("date", reading.Date))
+	} else {
+		stats.Imported++
+		logger.Debug("imported reading", slog.String("date", reading.Date))
+	}
+
+	return nil
+}
+
+// parsePsalms converts "Psalm 111; 149" to []string{"111", "149"}
+func parsePsalms(raw string) []string {
+	result := []string{}
+
+	// Remove "Psalm" or "Psalms" prefix if present
+	raw = strings.TrimPrefix(raw, "Psalm ")
+	raw = strings.TrimPrefix(raw, "Psalms ")
+	raw = strings.TrimPrefix(raw, "Ps. ")
+	raw = strings.TrimPrefix(raw, "Pss. ")
+
+	// Split on semicolon
+	for _, part := range strings.Split(raw, ";") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+
+	return result
+}
+
+// =============================================================================
+// Validation
+// =============================================================================
+
+// InvalidPsalm is a psalm citation that failed validation.
+type InvalidPsalm struct {
+	Date   string `json:"date"`   // Date of the entry (YYYY-MM-DD)
+	Office string `json:"office"` // "morning" or "evening"
+	Psalm  string `json:"psalm"`  // The citation as it will be stored
+	Error  string `json:"error"`  // Why it was rejected
+}
+
+// ValidatePsalms checks every morning and evening psalm in the dataset,
+// as it will be split for storage, and returns the malformed ones in date
+// order. An empty result means every psalm is well formed.
+func ValidatePsalms(data *ScraperData) []InvalidPsalm {
+	invalid := []InvalidPsalm{}
+
+	for _, date := range data.Dates() {
+		entry := data.ReadingsByDate[date]
+		offices := []struct {
+			name string
+			raw  string
+		}{
+			{"morning", entry.Readings.Morning},
+			{"evening", entry.Readings.Evening},
+		}
+
+		for _, office := range offices {
+			for _, psalm := range parsePsalms(office.raw) {
+				if err := scripture.ValidatePsalm(psalm); err != nil {
+					invalid = append(invalid, InvalidPsalm{
+						Date:   date,
+						Office: office.name,
+						Psalm:  psalm,
+						Error:  err.Error(),
+					})
+				}
+			}
+		}
+	}
+
+	return invalid
+}
+
+// =============================================================================
+// Export
+// =============================================================================
+
+// exportSource is the metadata source recorded in exported files.
+const exportSource = "lectionary-api export"
+
+// Export reads every reading in the database back into the scraper format
+// Import consumes, so importing the result reproduces the same readings.
+func Export(ctx context.Context, db *database.DB) (*ScraperData, error) {
+	data := &ScraperData{
+		Metadata: ScraperMetadata{
+			ExportedAt: time.Now().UTC().Format(scrapedAtLayout),
+			Source:     exportSource,
+		},
+		ReadingsByDate: make(map[string]ScraperDateEntry),
+	}
+
+	var first, last string
+	err := db.StreamReadingsByDateRange(ctx, "0000-01-01", "9999-12-31", func(reading *database.DailyReading) error {
+		data.ReadingsByDate[reading.Date] = exportReading(reading)
+		if first == "" {
+			first = reading.Date
+		}
+		last = reading.Date
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export readings: %w", err)
+	}
+
+	data.Metadata.TotalDates = len(data.ReadingsByDate)
+	if first != "" {
+		data.Metadata.DateRange = &ScraperDateRange{Start: first, End: last}
+	}
+
+	return data, nil
+}
+
+// exportReading converts a stored reading back into a scraper entry.
+func exportReading(reading *database.DailyReading) ScraperDateEntry {
+	entry := ScraperDateEntry{
+		Date: reading.Date,
+		URL:  reading.SourceURL,
+		Readings: ScraperReading{
+			Morning:       formatPsalms(reading.MorningPsalms),
+			FirstReading:  reading.FirstReading,
+			SecondReading: reading.SecondReading,
+			GospelReading: reading.GospelReading,
+			Canticle:      reading.Canticle,
+			Evening:       formatPsalms(reading.EveningPsalms),
+		},
+	}
+	if reading.ScrapedAt != nil {
+		entry.ScrapedAt = reading.ScrapedAt.UTC().Format(scrapedAtLayout)
+	}
+	return entry
+}
+
+// formatPsalms is the inverse of parsePsalms: []string{"111", "149"}
+// becomes "Psalm 111; 149".
+func formatPsalms(psalms []string) string {
+	if len(psalms) == 0 {
+		return ""
+	}
+	return "Psalm " + strings.Join(psalms, "; ")
+}
+
+// =============================================================================
+// Preflight
+// =============================================================================
+
+// PreflightReport describes how a dataset would cover a date range.
+type PreflightReport struct {
+	Start           string   `json:"start"`            // First date checked (YYYY-MM-DD)
+	End             string   `json:"end"`              // Last date checked (YYYY-MM-DD)
+	DatesInFile     int      `json:"dates_in_file"`    // Entries in the dataset
+	Imported        int      `json:"imported"`         // Entries that imported cleanly
+	Failed          int      `json:"failed"`           // Entries that failed to import
+	CheckedDays     int      `json:"checked_days"`     // Calendar days in the range
+	MissingDates    []string `json:"missing_dates"`    // Days with no readings at all
+	IncompleteDates []string `json:"incomplete_dates"` // Days missing a first, second, or gospel reading
+	OK              bool     `json:"ok"`               // True if nothing is missing, incomplete, or failed
+}
+
+// Preflight imports the dataset into a temporary in-memory database and
+// looks up every day from start through end against it, reporting the days
+// that would have no readings or incomplete readings. The real database is
+// never touched.
+func Preflight(ctx context.Context, data *ScraperData, start, end time.Time, logger *slog.Logger) (*PreflightReport, error) {
+	db, err := database.Open(database.Config{
+		Path:            ":memory:",
+		MaxOpenConns:    1, // Each connection to :memory: is a separate database
+		MaxIdleConns:    1,
+		ConnMaxLifetime: 0,
+	}, logger)
+	if err != nil {
+		return nil, fmt.Errorf("open preflight database: %w", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Migrate(ctx); err != nil {
+		return nil, fmt.Errorf("migrate preflight database: %w", err)
+	}
+
+	stats, err := Import(ctx, db, data, logger)
+	if err != nil {
+		return nil, fmt.Errorf("import into preflight database: %w", err)
+	}
+
+	report := &PreflightReport{
+		Start:           start.Format("2006-01-02"),
+		End:             end.Format("2006-01-02"),
+		DatesInFile:     len(data.ReadingsByDate),
+		Imported:        stats.Imported + stats.Updated,
+		Failed:          stats.Failed,
+		MissingDates:    []string{},
+		IncompleteDates: []string{},
+	}
+
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		report.CheckedDays++
+
+		reading, err := db.GetReadingByDate(ctx, date)
+		if err != nil {
+			if database.IsNotFound(err) {
+				report.MissingDates = append(report.MissingDates, date)
+				continue
+			}
+			return nil, fmt.Errorf("check %s: %w", date, err)
+		}
+
+		for _, t := range database.ValidReadingTypes {
+			if !t.Optional() && reading.Reference(t) == "" {
+				report.IncompleteDates = append(report.IncompleteDates, date)
+				break
+			}
+		}
+	}
+
+	report.OK = len(report.MissingDates) == 0 && len(report.IncompleteDates) == 0 && report.Failed == 0
+
+	return report, nil
+}
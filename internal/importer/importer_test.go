@@ -0,0 +1,231 @@
+package importer
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zapponejosh/lectionary-api/internal/database"
+)
+
+func testLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelError, // Quiet during tests
+	}))
+}
+
+func TestParsePsalms(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want []string
+	}{
+		{"Psalm 111; 149", []string{"111", "149"}},
+		{"Psalms 98; 147:1-11", []string{"98", "147:1-11"}},
+		{"Ps. 23", []string{"23"}},
+		{"Pss. 1; 2 ;", []string{"1", "2"}},
+		{"", []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := parsePsalms(tt.raw); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePsalms(%q) = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestImport_Idempotent(t *testing.T) {
+	db, err := database.Open(database.Config{Path: ":memory:", MaxOpenConns: 1, MaxIdleConns: 1}, testLogger())
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if _, err := db.Migrate(ctx); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	data, err := Parse([]byte(`{
+		"metadata": {"source": "test"},
+		"readings_by_date": {
+			"2025-01-02": {"date": "2025-01-02", "readings": {"Morning": "Psalm 1", "Gospel": "John 1:1-5"}, "scraped_at": "2026-01-03T12:04:24.723240"},
+			"2025-01-01": {"date": "2025-01-01", "readings": {"Morning": "Psalm 2", "Gospel": "John 1:6-8"}}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	stats, err := Import(ctx, db, data, testLogger())
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if stats.Imported != 2 || stats.Updated != 0 {
+		t.Errorf("first import: %+v, want 2 imported", stats)
+	}
+
+	stats, err = Import(ctx, db, data, testLogger())
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if stats.Imported != 0 || stats.Updated != 2 {
+		t.Errorf("second import: %+v, want 2 updated", stats)
+	}
+
+	// Re-importing corrected data replaces the stored readings
+	entry := data.ReadingsByDate["2025-01-01"]
+	entry.Readings.Morning = "Psalm 3; 4"
+	entry.Readings.GospelReading = "John 1:6-9"
+	data.ReadingsByDate["2025-01-01"] = entry
+
+	stats, err = Import(ctx, db, data, testLogger())
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if stats.Imported != 0 || stats.Updated != 2 || stats.Failed != 0 {
+		t.Errorf("corrected import: %+v, want 2 updated", stats)
+	}
+
+	reading, err := db.GetReadingByDate(ctx, "2025-01-01")
+	if err != nil {
+		t.Fatalf("GetReadingByDate: %v", err)
+	}
+	if reading.GospelReading != "John 1:6-9" {
+		t.Errorf("GospelReading = %q, want %q", reading.GospelReading, "John 1:6-9")
+	}
+	if want := []string{"3", "4"}; !reflect.DeepEqual(reading.MorningPsalms, want) {
+		t.Errorf("MorningPsalms = %v, want %v", reading.MorningPsalms, want)
+	}
+}
+
+func TestValidatePsalms(t *testing.T) {
+	data := &ScraperData{ReadingsByDate: map[string]ScraperDateEntry{
+		"2025-01-02": {Date: "2025-01-02", Readings: ScraperReading{Morning: "Psalm 48; 147:12-20", Evening: "Psalm 9; 151"}},
+		"2025-01-01": {Date: "2025-01-01", Readings: ScraperReading{Morning: "Psalm 98; 147:1-11", Evening: "Psalm 99; 8"}},
+		"2025-01-03": {Date: "2025-01-03", Readings: ScraperReading{Morning: "Psalm 111:abc", Evening: "Canticle 3"}},
+	}}
+
+	got := ValidatePsalms(data)
+
+	want := []InvalidPsalm{
+		{Date: "2025-01-02", Office: "evening", Psalm: "151"},
+		{Date: "2025-01-03", Office: "morning", Psalm: "111:abc"},
+		{Date: "2025-01-03", Office: "evening", Psalm: "Canticle 3"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ValidatePsalms returned %d entries, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i].Date != want[i].Date || got[i].Office != want[i].Office || got[i].Psalm != want[i].Psalm {
+			t.Errorf("entry %d = %+v, want %+v", i, got[i], want[i])
+		}
+		if got[i].Error == "" {
+			t.Errorf("entry %d has no error message", i)
+		}
+	}
+}
+
+func TestPreflight_IncompleteDataset(t *testing.T) {
+	data := &ScraperData{ReadingsByDate: map[string]ScraperDateEntry{
+		"2025-01-01": {Date: "2025-01-01", Readings: ScraperReading{FirstReading: "Gen 1", SecondReading: "Rom 1", GospelReading: "John 1"}},
+		"2025-01-02": {Date: "2025-01-02", Readings: ScraperReading{FirstReading: "Gen 2", GospelReading: "John 2"}},
+		"2025-01-04": {Date: "2025-01-04", Readings: ScraperReading{FirstReading: "Gen 4", SecondReading: "Rom 4", GospelReading: "John 4"}},
+	}}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	report, err := Preflight(context.Background(), data, start, end, testLogger())
+	if err != nil {
+		t.Fatalf("Preflight: %v", err)
+	}
+
+	if want := []string{"2025-01-03", "2025-01-05"}; !reflect.DeepEqual(report.MissingDates, want) {
+		t.Errorf("MissingDates = %v, want %v", report.MissingDates, want)
+	}
+	if want := []string{"2025-01-02"}; !reflect.DeepEqual(report.IncompleteDates, want) {
+		t.Errorf("IncompleteDates = %v, want %v", report.IncompleteDates, want)
+	}
+	if report.CheckedDays != 5 || report.Imported != 3 {
+		t.Errorf("CheckedDays = %d, Imported = %d, want 5 and 3", report.CheckedDays, report.Imported)
+	}
+	if report.OK {
+		t.Error("OK should be false")
+	}
+}
+
+func TestExport_RoundTrip(t *testing.T) {
+	open := func() *database.DB {
+		db, err := database.Open(database.Config{Path: ":memory:", MaxOpenConns: 1, MaxIdleConns: 1}, testLogger())
+		if err != nil {
+			t.Fatalf("open database: %v", err)
+		}
+		if _, err := db.Migrate(context.Background()); err != nil {
+			t.Fatalf("migrate: %v", err)
+		}
+		return db
+	}
+
+	ctx := context.Background()
+	source := open()
+	defer source.Close()
+
+	fixture, err := Parse([]byte(`{
+		"metadata": {"source": "test"},
+		"readings_by_date": {
+			"2025-01-01": {
+				"date": "2025-01-01",
+				"url": "https://example.com/2025-01-01",
+				"readings": {"Morning": "Psalm 98; 147:1-11", "First Reading": "Genesis 17:1-12a, 15-16", "Second Reading": "Colossians 2:6-12", "Gospel": "John 16:23b-30", "Evening": "Psalm 99; 8"},
+				"scraped_at": "2026-01-03T12:04:24.72324"
+			},
+			"2025-01-02": {
+				"date": "2025-01-02",
+				"url": "https://example.com/2025-01-02",
+				"readings": {"Morning": "Psalm 1", "Gospel": "John 1:1-5"},
+				"scraped_at": "2026-01-03T12:04:25"
+			}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if _, err := Import(ctx, source, fixture, testLogger()); err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+
+	exported, err := Export(ctx, source)
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if !reflect.DeepEqual(exported.ReadingsByDate, fixture.ReadingsByDate) {
+		t.Errorf("exported entries = %+v\nwant %+v", exported.ReadingsByDate, fixture.ReadingsByDate)
+	}
+	if exported.Metadata.TotalDates != 2 || exported.Metadata.DateRange == nil ||
+		*exported.Metadata.DateRange != (ScraperDateRange{Start: "2025-01-01", End: "2025-01-02"}) {
+		t.Errorf("metadata = %+v", exported.Metadata)
+	}
+
+	// Importing the export reproduces the same readings
+	copied := open()
+	defer copied.Close()
+	if _, err := Import(ctx, copied, exported, testLogger()); err != nil {
+		t.Fatalf("Import export: %v", err)
+	}
+	for _, date := range fixture.Dates() {
+		want, err := source.GetReadingByDate(ctx, date)
+		if err != nil {
+			t.Fatalf("source %s: %v", date, err)
+		}
+		got, err := copied.GetReadingByDate(ctx, date)
+		if err != nil {
+			t.Fatalf("copy %s: %v", date, err)
+		}
+		if !reflect.DeepEqual(exportReading(got), exportReading(want)) {
+			t.Errorf("%s: round trip = %+v, want %+v", date, got, want)
+		}
+	}
+}
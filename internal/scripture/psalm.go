@@ -0,0 +1,63 @@
+package scripture
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// psalmPattern matches a stored psalm citation: a psalm number with an
+// optional verse selection, e.g. "98", "147:1-11", "119:145-176".
+var psalmPattern = regexp.MustCompile(`^(?:Pss?\.?\s+|Psalms?\s+)?(\d{1,3})(?::\s*(.+))?$`)
+
+// Psalm is a single psalm citation split into its parts.
+type Psalm struct {
+	Number int     `json:"psalm,omitempty"` // 1-150; omitted if the citation could not be parsed
+	Verses *string `json:"verses"`          // "145-176", or nil for the whole psalm
+	Raw    string  `json:"raw"`             // The citation as stored
+}
+
+// ParsePsalm parses a psalm citation such as "119:145-176" or "98".
+// A leading "Psalm"/"Ps." is tolerated. Returns an error if the citation
+// doesn't start with a psalm number between 1 and 150.
+func ParsePsalm(raw string) (Psalm, error) {
+	p := Psalm{Raw: raw}
+
+	m := psalmPattern.FindStringSubmatch(strings.TrimSpace(dashReplacer.Replace(raw)))
+	if m == nil {
+		return p, fmt.Errorf("invalid psalm citation %q", raw)
+	}
+
+	n, _ := strconv.Atoi(m[1])
+	if n < 1 || n > 150 {
+		return p, fmt.Errorf("psalm number out of range in %q", raw)
+	}
+	p.Number = n
+
+	if verses := strings.TrimSpace(m[2]); verses != "" {
+		verses = tightPunctPattern.ReplaceAllString(verses, "$1")
+		p.Verses = &verses
+	}
+
+	return p, nil
+}
+
+// psalmVersesPattern matches a verse selection within one psalm: verse
+// numbers with an optional a/b part, as ranges or comma-separated lists,
+// e.g. "1-11", "1-7, 13", "23b-30".
+var psalmVersesPattern = regexp.MustCompile(`^\d+[a-z]?(?:-\d+[a-z]?)?(?:,\s*\d+[a-z]?(?:-\d+[a-z]?)?)*$`)
+
+// ValidatePsalm reports whether raw is a well-formed psalm citation: a psalm
+// number between 1 and 150, optionally followed by a verse selection.
+// It is stricter than ParsePsalm, which accepts any text after the colon.
+func ValidatePsalm(raw string) error {
+	p, err := ParsePsalm(raw)
+	if err != nil {
+		return err
+	}
+	if p.Verses != nil && !psalmVersesPattern.MatchString(*p.Verses) {
+		return fmt.Errorf("invalid verse selection in %q", raw)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package scripture
+
+import "testing"
+
+func TestParsePsalm(t *testing.T) {
+	tests := []struct {
+		raw        string
+		wantNumber int
+		wantVerses string // "" means nil
+		wantErr    bool
+	}{
+		{"98", 98, "", false},
+		{"119:145-176", 119, "145-176", false},
+		{"147:1-11", 147, "1-11", false},
+		{"Psalm 8", 8, "", false},
+		{"22:1 – 21", 22, "1-21", false},
+		{"151", 0, "", true},
+		{"Canticle 3", 0, "", true},
+		{"", 0, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			p, err := ParsePsalm(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePsalm(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if p.Raw != tt.raw {
+				t.Errorf("Raw = %q, want %q", p.Raw, tt.raw)
+			}
+			if tt.wantErr {
+				return
+			}
+			if p.Number != tt.wantNumber {
+				t.Errorf("Number = %d, want %d", p.Number, tt.wantNumber)
+			}
+			switch {
+			case tt.wantVerses == "" && p.Verses != nil:
+				t.Errorf("Verses = %q, want nil", *p.Verses)
+			case tt.wantVerses != "" && (p.Verses == nil || *p.Verses != tt.wantVerses):
+				t.Errorf("Verses = %v, want %q", p.Verses, tt.wantVerses)
+			}
+		})
+	}
+}
+
+func TestValidatePsalm(t *testing.T) {
+	valid := []string{"98", "147:1-11", "119:145-176", "22:1 – 21", "78:1-7, 13", "37:1-18b", "Psalm 8"}
+	for _, raw := range valid {
+		if err := ValidatePsalm(raw); err != nil {
+			t.Errorf("ValidatePsalm(%q) = %v, want nil", raw, err)
+		}
+	}
+
+	invalid := []string{"", "0", "151", "Canticle 3", "119:", "119:abc", "23:1-", "23:1--6", "23 1-6", "147:1-11; 148"}
+	for _, raw := range invalid {
+		if err := ValidatePsalm(raw); err == nil {
+			t.Errorf("ValidatePsalm(%q) = nil, want error", raw)
+		}
+	}
+}
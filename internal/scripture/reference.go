@@ -0,0 +1,171 @@
+// Package scripture parses scripture references as they appear in the
+// lectionary data, e.g. "Genesis 17:1-12a, 15-16" or
+// "1 Timothy 6:12-16, Zechariah 12:9-11; 13:1, 7-9".
+package scripture
+
+import (
+	"regexp"
+	"strings"
+)
+
+// bookPattern matches a book name at the start of a citation: an optional
+// numeric prefix ("1 ", "2 ", "3 "), a capitalized word, and any further words
+// ("Song of Solomon"), followed by a chapter number.
+var bookPattern = regexp.MustCompile(`(?:^|[;,]\s*)((?:[1-3]\s*)?[A-Z][A-Za-z]*\.?(?:\s+[A-Za-z]+\.?)*)\s+\d`)
+
+// partialVersePattern matches a verse number with a partial-verse letter
+// suffix, e.g. the "23b" in "16:23b-30".
+var partialVersePattern = regexp.MustCompile(`(\d+)[a-e]\b`)
+
+// dashReplacer maps the dash variants found in source data to a hyphen.
+var dashReplacer = strings.NewReplacer(
+	"\u2010", "-", // hyphen
+	"\u2012", "-", // figure dash
+	"\u2013", "-", // en dash
+	"\u2014", "-", // em dash
+	"\u2212", "-", // minus sign
+)
+
+// tightPunctPattern and listPunctPattern normalize spacing around separators.
+var (
+	tightPunctPattern = regexp.MustCompile(`\s*([-:])\s*`)
+	listPunctPattern  = regexp.MustCompile(`\s*([,;])\s*`)
+)
+
+// Normalize rewrites a reference into a canonical form so references that
+// differ only in punctuation compare equal: dashes become hyphens, spacing
+// around separators is standardized, and the book name is normalized.
+//
+// Example: "1John 4:7 – 16 ,21" → "1 John 4:7-16, 21"
+func Normalize(reference string) string {
+	ref := dashReplacer.Replace(reference)
+	ref = strings.Join(strings.Fields(ref), " ")
+	ref = tightPunctPattern.ReplaceAllString(ref, "$1")
+	ref = listPunctPattern.ReplaceAllString(ref, "$1 ")
+	ref = strings.TrimRight(ref, " ,;.")
+
+	// Normalize the leading book name ("1John" → "1 John")
+	if loc := bookPattern.FindStringSubmatchIndex(ref); loc != nil && loc[2] == 0 {
+		ref = NormalizeBook(ref[:loc[3]]) + ref[loc[3]:]
+	}
+
+	return ref
+}
+
+// Equal reports whether two references cite the same passage once
+// normalized. Book names compare case-insensitively.
+func Equal(a, b string) bool {
+	return strings.EqualFold(Normalize(a), Normalize(b))
+}
+
+// Books returns the normalized names of every book cited in a reference,
+// in order of appearance and without duplicates.
+//
+// Example: "1 Timothy 6:12-16, Zechariah 12:9-11; 13:1" → ["1 Timothy", "Zechariah"]
+func Books(reference string) []string {
+	var books []string
+	seen := make(map[string]bool)
+
+	for _, m := range bookPattern.FindAllStringSubmatch(reference, -1) {
+		book := NormalizeBook(m[1])
+		if book == "" || seen[book] {
+			continue
+		}
+		seen[book] = true
+		books = append(books, book)
+	}
+
+	return books
+}
+
+// Passages splits a reference into its separate passages, each starting
+// with its book. A new book starts a new passage, and so does a ";"
+// chapter continuation, which carries the previous book forward.
+// Passages without any book name (a bare "13:1") are returned as is.
+//
+// Example: "1 Timothy 6:12-16, Zechariah 12:9-11; 13:1, 7-9" →
+// ["1 Timothy 6:12-16", "Zechariah 12:9-11", "Zechariah 13:1, 7-9"]
+func Passages(reference string) []string {
+	var passages []string
+	book := ""
+
+	for _, segment := range strings.Split(reference, ";") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+
+		// Cut the segment where each further book begins
+		starts := []int{0}
+		for _, loc := range bookPattern.FindAllStringSubmatchIndex(segment, -1) {
+			if loc[2] > 0 {
+				starts = append(starts, loc[2])
+			}
+		}
+
+		for i, start := range starts {
+			end := len(segment)
+			if i+1 < len(starts) {
+				end = starts[i+1]
+			}
+			passage := strings.TrimRight(strings.TrimSpace(segment[start:end]), ",")
+			if passage == "" {
+				continue
+			}
+
+			if loc := bookPattern.FindStringSubmatchIndex(passage); loc != nil && loc[2] == 0 {
+				book = passage[:loc[3]]
+			} else if book != "" {
+				passage = book + " " + passage
+			}
+			passages = append(passages, passage)
+		}
+	}
+
+	return passages
+}
+
+// HasBook reports whether a reference cites the given book.
+// Matching is on the whole book name, so "John" does not match "1 John".
+func HasBook(reference, book string) bool {
+	want := NormalizeBook(book)
+	if want == "" {
+		return false
+	}
+
+	for _, b := range Books(reference) {
+		if strings.EqualFold(b, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeBook tidies a book name for display and comparison:
+// whitespace is collapsed, trailing periods are dropped, and a numeric
+// prefix is separated from the name ("1John" → "1 John").
+func NormalizeBook(book string) string {
+	book = strings.TrimSpace(book)
+	if book == "" {
+		return ""
+	}
+
+	// Separate "1John" into "1 John"
+	if len(book) > 1 && book[0] >= '1' && book[0] <= '3' && book[1] != ' ' {
+		book = book[:1] + " " + book[1:]
+	}
+
+	fields := strings.Fields(book)
+	for i, f := range fields {
+		fields[i] = strings.TrimSuffix(f, ".")
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// WholeVerses rounds partial-verse citations to their enclosing whole verses
+// by dropping the letter suffixes: "John 16:23b-30" → "John 16:23-30".
+// References without partial verses are returned unchanged.
+func WholeVerses(reference string) string {
+	return partialVersePattern.ReplaceAllString(reference, "$1")
+}
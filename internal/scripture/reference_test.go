@@ -0,0 +1,144 @@
+package scripture
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBooks(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      []string
+	}{
+		{"John 16:23b-30", []string{"John"}},
+		{"1 John 4:7-16", []string{"1 John"}},
+		{"Genesis 17:1-12a, 15-16", []string{"Genesis"}},
+		{"Song of Solomon 2:8-13", []string{"Song of Solomon"}},
+		{"1 Timothy 6:12-16, Zechariah 12:9-11; 13:1, 7-9", []string{"1 Timothy", "Zechariah"}},
+		{"John 11:1-27; 12:1-10", []string{"John"}},
+		{"Jeremiah 29:1 (2-3) 4-14", []string{"Jeremiah"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got := Books(tt.reference)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Books(%q) = %v, want %v", tt.reference, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassages(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      []string
+	}{
+		{"John 16:23b-30", []string{"John 16:23b-30"}},
+		{"Genesis 17:1-12a, 15-16", []string{"Genesis 17:1-12a, 15-16"}},
+		{"John 11:1-27; 12:1-10", []string{"John 11:1-27", "John 12:1-10"}},
+		{"1 Timothy 6:12-16, Zechariah 12:9-11; 13:1, 7-9", []string{"1 Timothy 6:12-16", "Zechariah 12:9-11", "Zechariah 13:1, 7-9"}},
+		{"Song of Solomon 2:8-13; Romans 8:1-4", []string{"Song of Solomon 2:8-13", "Romans 8:1-4"}},
+		{"147:1-11", []string{"147:1-11"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got := Passages(tt.reference)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Passages(%q) = %q, want %q", tt.reference, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasBook(t *testing.T) {
+	tests := []struct {
+		reference string
+		book      string
+		want      bool
+	}{
+		{"John 3:1-17", "John", true},
+		{"John 3:1-17", "john", true},
+		{"1 John 4:7-16", "John", false},
+		{"2 John 1-13", "John", false},
+		{"3 John 1-15", "John", false},
+		{"1 John 4:7-16", "1 John", true},
+		{"1 John 4:7-16", "1John", true},
+		{"1 Timothy 6:12-16, Zechariah 12:9-11", "Zechariah", true},
+		{"Romans 8:1-11", "John", false},
+		{"John 3:1-17", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference+"/"+tt.book, func(t *testing.T) {
+			if got := HasBook(tt.reference, tt.book); got != tt.want {
+				t.Errorf("HasBook(%q, %q) = %v, want %v", tt.reference, tt.book, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeBook(t *testing.T) {
+	tests := map[string]string{
+		"John":             "John",
+		"  1   John ":      "1 John",
+		"1John":            "1 John",
+		"Song of  Solomon": "Song of Solomon",
+		"Gen.":             "Gen",
+		"":                 "",
+	}
+
+	for in, want := range tests {
+		if got := NormalizeBook(in); got != want {
+			t.Errorf("NormalizeBook(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestWholeVerses(t *testing.T) {
+	tests := map[string]string{
+		"John 16:23b-30":               "John 16:23-30",
+		"Genesis 17:1-12a, 15-16":      "Genesis 17:1-12, 15-16",
+		"Isaiah 40:1-11":               "Isaiah 40:1-11",
+		"1 John 4:7-16":                "1 John 4:7-16",
+		"2 Samuel 7:1-11, 16b; 8:1a-3": "2 Samuel 7:1-11, 16; 8:1-3",
+	}
+
+	for in, want := range tests {
+		if got := WholeVerses(in); got != want {
+			t.Errorf("WholeVerses(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"John 3:1-17":             "John 3:1-17",
+		"John 3:1\u201317":        "John 3:1-17",
+		"John  3 : 1 \u2014 17":   "John 3:1-17",
+		"1John 4:7 - 16 ,21":      "1 John 4:7-16, 21",
+		"John 11:1-27;12:1-10.":   "John 11:1-27; 12:1-10",
+		"Song of  Solomon 2:8-13": "Song of Solomon 2:8-13",
+	}
+
+	for in, want := range tests {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	if !Equal("john 3:1\u201317", "John 3:1-17") {
+		t.Error("Equal should ignore dash style and book case")
+	}
+	if Equal("John 3:1-17", "John 3:1-16") {
+		t.Error("Equal should not match different verse ranges")
+	}
+	if Equal("John 3:1-17", "1 John 3:1-17") {
+		t.Error("Equal should not match different books")
+	}
+}